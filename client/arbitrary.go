@@ -0,0 +1,144 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// arbitraryMsgType is the amino type of the message wrapping data signed off-chain, as defined by ADR-036.
+const arbitraryMsgType = "sign/MsgSignData"
+
+// ArbitrarySignature is an ADR-036 signature over arbitrary data, along with the data and signer public key.
+type ArbitrarySignature struct {
+	Signer    string `json:"signer"`    // Signer is the Bech32 account address of the signer.
+	Data      []byte `json:"data"`      // Data is the signed data.
+	PubKey    []byte `json:"pub_key"`   // PubKey is the compressed secp256k1 public key of the signer.
+	Signature []byte `json:"signature"` // Signature is the signature over the ADR-036 sign document.
+}
+
+// arbitraryMsg is the ADR-036 message wrapping the data signed off-chain.
+type arbitraryMsg struct {
+	Type  string `json:"type"`
+	Value struct {
+		Data   string `json:"data"`
+		Signer string `json:"signer"`
+	} `json:"value"`
+}
+
+// arbitraryFee is the empty fee of an ADR-036 sign document.
+type arbitraryFee struct {
+	Amount []cosmossdk.Coin `json:"amount"`
+	Gas    string           `json:"gas"`
+}
+
+// arbitrarySignDoc is the ADR-036 sign document.
+type arbitrarySignDoc struct {
+	AccountNumber string         `json:"account_number"`
+	ChainID       string         `json:"chain_id"`
+	Fee           arbitraryFee   `json:"fee"`
+	Memo          string         `json:"memo"`
+	Msgs          []arbitraryMsg `json:"msgs"`
+	Sequence      string         `json:"sequence"`
+}
+
+// arbitrarySignBytes returns the ADR-036 sign bytes of the data for the signer. The sign document
+// has an empty chain ID, zero account number and sequence, and no fee, so it can never be broadcast.
+func arbitrarySignBytes(signer string, data []byte) ([]byte, error) {
+	msg := arbitraryMsg{
+		Type: arbitraryMsgType,
+	}
+
+	msg.Value.Data = base64.StdEncoding.EncodeToString(data)
+	msg.Value.Signer = signer
+
+	doc := arbitrarySignDoc{
+		AccountNumber: "0",
+		Fee: arbitraryFee{
+			Amount: []cosmossdk.Coin{},
+			Gas:    "0",
+		},
+		Msgs:     []arbitraryMsg{msg},
+		Sequence: "0",
+	}
+
+	// Encode the document as canonical JSON with sorted keys.
+	buf, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return cosmossdk.SortJSON(buf)
+}
+
+// SignArbitrary signs the data off-chain with the key of the given name, following ADR-036.
+// It returns the signature along with the data, signer address and public key.
+func (c *Client) SignArbitrary(name string, data []byte, opts *Options) (*ArbitrarySignature, error) {
+	// Retrieve the signing key from the keyring.
+	key, err := c.Key(name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := key.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the sign bytes for the signer address.
+	signer := addr.String()
+	buf, err := arbitrarySignBytes(signer, data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign the sign bytes using the key from the keyring.
+	signature, pubKey, err := c.Sign(name, buf, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+		return nil, fmt.Errorf("unsupported public key type %T", pubKey)
+	}
+
+	return &ArbitrarySignature{
+		Signer:    signer,
+		Data:      data,
+		PubKey:    pubKey.Bytes(),
+		Signature: signature,
+	}, nil
+}
+
+// VerifyArbitrary verifies an ADR-036 signature. It checks that the public key belongs to the
+// signer address and that the signature is valid for the data.
+func (c *Client) VerifyArbitrary(sig *ArbitrarySignature) error {
+	addr, err := cosmossdk.AccAddressFromBech32(sig.Signer)
+	if err != nil {
+		return err
+	}
+
+	// Check that the public key matches the signer address.
+	pubKey := &secp256k1.PubKey{Key: sig.PubKey}
+	if len(sig.PubKey) != secp256k1.PubKeySize {
+		return fmt.Errorf("invalid public key length %d", len(sig.PubKey))
+	}
+	if !addr.Equals(cosmossdk.AccAddress(pubKey.Address())) {
+		return fmt.Errorf("public key does not belong to signer %s", sig.Signer)
+	}
+
+	// Rebuild the sign bytes and verify the signature.
+	buf, err := arbitrarySignBytes(sig.Signer, sig.Data)
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(buf, sig.Signature) {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
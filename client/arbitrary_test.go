@@ -0,0 +1,111 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestArbitrarySignBytes(t *testing.T) {
+	tests := []struct {
+		name   string
+		signer string
+		data   []byte
+		want   string
+	}{
+		{
+			name:   "text",
+			signer: "sent1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+			data:   []byte("hello"),
+			want: `{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"",` +
+				`"msgs":[{"type":"sign/MsgSignData","value":{"data":"aGVsbG8=","signer":"sent1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"}}],"sequence":"0"}`,
+		},
+		{
+			name:   "empty data",
+			signer: "sent1signer",
+			data:   nil,
+			want: `{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"",` +
+				`"msgs":[{"type":"sign/MsgSignData","value":{"data":"","signer":"sent1signer"}}],"sequence":"0"}`,
+		},
+		{
+			name:   "escaped data",
+			signer: "sent1signer",
+			data:   []byte{0xff, 0x00, '<', '>'},
+			want: `{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"",` +
+				`"msgs":[{"type":"sign/MsgSignData","value":{"data":"/wA8Pg==","signer":"sent1signer"}}],"sequence":"0"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := arbitrarySignBytes(tt.signer, tt.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("arbitrarySignBytes() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_VerifyArbitrary(t *testing.T) {
+	var (
+		privKey = secp256k1.GenPrivKey()
+		other   = secp256k1.GenPrivKey()
+		signer  = cosmossdk.AccAddress(privKey.PubKey().Address()).String()
+		data    = []byte("hello")
+	)
+
+	buf, err := arbitrarySignBytes(signer, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signature, err := privKey.Sign(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		sig     *ArbitrarySignature
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			sig:     &ArbitrarySignature{Signer: signer, Data: data, PubKey: privKey.PubKey().Bytes(), Signature: signature},
+			wantErr: false,
+		},
+		{
+			name:    "tampered data",
+			sig:     &ArbitrarySignature{Signer: signer, Data: []byte("hellO"), PubKey: privKey.PubKey().Bytes(), Signature: signature},
+			wantErr: true,
+		},
+		{
+			name:    "public key of another account",
+			sig:     &ArbitrarySignature{Signer: signer, Data: data, PubKey: other.PubKey().Bytes(), Signature: signature},
+			wantErr: true,
+		},
+		{
+			name:    "invalid public key length",
+			sig:     &ArbitrarySignature{Signer: signer, Data: data, PubKey: []byte{0x02}, Signature: signature},
+			wantErr: true,
+		},
+		{
+			name:    "invalid signer",
+			sig:     &ArbitrarySignature{Signer: "invalid", Data: data, PubKey: privKey.PubKey().Bytes(), Signature: signature},
+			wantErr: true,
+		},
+	}
+
+	c := NewDefault()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := c.VerifyArbitrary(tt.sig); (err != nil) != tt.wantErr {
+				t.Errorf("VerifyArbitrary() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
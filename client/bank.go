@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/sentinel-official/sentinel-go-sdk/denoms"
+)
+
+const (
+	// gRPC methods for querying bank information
+	methodQueryBalance        = "/cosmos.bank.v1beta1.Query/Balance"
+	methodQueryBalances       = "/cosmos.bank.v1beta1.Query/AllBalances"
+	methodQueryDenomMetadata  = "/cosmos.bank.v1beta1.Query/DenomMetadata"
+	methodQueryDenomsMetadata = "/cosmos.bank.v1beta1.Query/DenomsMetadata"
+)
+
+// Balance queries and returns the balance of a specific denom for the given account address.
+// It uses gRPC to send a request to the "/cosmos.bank.v1beta1.Query/Balance" endpoint.
+// The result is a pointer to cosmossdk.Coin and an error if the query fails.
+func (c *Client) Balance(ctx context.Context, accAddr cosmossdk.AccAddress, denom string, opts *Options) (res *cosmossdk.Coin, err error) {
+	// Initialize variables for the query.
+	var (
+		resp banktypes.QueryBalanceResponse
+		req  = &banktypes.QueryBalanceRequest{
+			Address: accAddr.String(),
+			Denom:   denom,
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryBalance, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the balance and a nil error.
+	return resp.Balance, nil
+}
+
+// Balances queries and returns all balances of the given account address.
+// It uses gRPC to send a request to the "/cosmos.bank.v1beta1.Query/AllBalances" endpoint.
+// The result is a cosmossdk.Coins and an error if the query fails.
+func (c *Client) Balances(ctx context.Context, accAddr cosmossdk.AccAddress, opts *Options) (res cosmossdk.Coins, err error) {
+	// Initialize variables for the query.
+	var (
+		resp banktypes.QueryAllBalancesResponse
+		req  = &banktypes.QueryAllBalancesRequest{
+			Address:    accAddr.String(),
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryBalances, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the balances and a nil error.
+	return resp.Balances, nil
+}
+
+// DenomMetadata queries and returns the metadata of the given denom.
+// It uses gRPC to send a request to the "/cosmos.bank.v1beta1.Query/DenomMetadata" endpoint.
+// The result is a pointer to banktypes.Metadata and an error if the query fails.
+func (c *Client) DenomMetadata(ctx context.Context, denom string, opts *Options) (res *banktypes.Metadata, err error) {
+	// Initialize variables for the query.
+	var (
+		resp banktypes.QueryDenomMetadataResponse
+		req  = &banktypes.QueryDenomMetadataRequest{
+			Denom: denom,
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryDenomMetadata, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the metadata and a nil error.
+	return &resp.Metadata, nil
+}
+
+// DenomsMetadata queries and returns the metadata of all denoms.
+// It uses gRPC to send a request to the "/cosmos.bank.v1beta1.Query/DenomsMetadata" endpoint.
+// The result is a slice of banktypes.Metadata and an error if the query fails.
+func (c *Client) DenomsMetadata(ctx context.Context, opts *Options) (res []banktypes.Metadata, err error) {
+	// Initialize variables for the query.
+	var (
+		resp banktypes.QueryDenomsMetadataResponse
+		req  = &banktypes.QueryDenomsMetadataRequest{
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryDenomsMetadata, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the metadata and a nil error.
+	return resp.Metadatas, nil
+}
+
+// RegisterDenomsMetadata queries the metadata of all denoms and adds it to the registry,
+// replacing the built-in metadata of the same denoms.
+func (c *Client) RegisterDenomsMetadata(ctx context.Context, r *denoms.Registry, opts *Options) error {
+	items, err := QueryAll(opts, func(opts *Options) ([]banktypes.Metadata, error) {
+		return c.DenomsMetadata(ctx, opts)
+	})
+	if err != nil {
+		return err
+	}
+
+	return r.RegisterBankMetadata(items...)
+}
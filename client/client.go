@@ -7,16 +7,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
 	"github.com/sentinel-official/sentinel-go-sdk/types"
 )
 
 // Client contains necessary components for transaction handling, encoding, and decoding.
 type Client struct {
-	sync.Mutex                                // Mutex to ensure thread-safe access
-	codec.ProtoCodecMarshaler                 // Marshaler for protobuf types
-	client.TxConfig                           // Configuration for transactions
-	kr                        keyring.Keyring // Keyring for managing keys
+	sync.Mutex                                                    // Mutex to ensure thread-safe access
+	codec.ProtoCodecMarshaler                                     // Marshaler for protobuf types
+	client.TxConfig                                               // Configuration for transactions
+	kr                        keyring.Keyring                     // Keyring for managing keys
+	versions                  map[string]string                   // Pinned and detected module query service versions
+	denomTraces               map[string]transfertypes.DenomTrace // Resolved IBC denom traces by denom
 }
 
 // New creates a new instance of Client with the provided ProtoCodecMarshaler.
@@ -0,0 +1,287 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+	"github.com/sentinel-official/sentinel-go-sdk/options"
+	"github.com/sentinel-official/sentinel-go-sdk/utils"
+)
+
+// Constants for locating the configuration.
+const (
+	ConfigFileName = "config"   // ConfigFileName is the name of the configuration file, without extension.
+	EnvPrefix      = "SENTINEL" // EnvPrefix is the prefix of the environment variables overriding the configuration.
+)
+
+// configFileExts lists the supported configuration file extensions, in the order they are looked up.
+var configFileExts = []string{".toml", ".yaml", ".yml", ".json"}
+
+// NewDefaultOptions creates and returns an Options instance with every option set to its default value.
+func NewDefaultOptions() *Options {
+	return &Options{
+		Key:     options.NewKey(),
+		Keyring: options.NewKeyring(),
+		Page:    options.NewPage(),
+		Query:   options.NewQuery(),
+		Tx:      options.NewTx(),
+	}
+}
+
+// HomeDirFromCmd returns the home directory from the "home" flag of the command if it is set,
+// then from the SENTINEL_HOME environment variable, and otherwise the default home directory.
+func HomeDirFromCmd(cmd *cobra.Command) (string, error) {
+	if f := cmd.Flags().Lookup("home"); f != nil && f.Changed {
+		return flags.GetHomeDir(cmd)
+	}
+	if v, ok := os.LookupEnv(EnvPrefix + "_HOME"); ok {
+		return v, nil
+	}
+
+	return flags.DefaultHomeDir, nil
+}
+
+// ConfigFilePath returns the path of the configuration file in the home directory.
+// It returns an empty string if no configuration file exists.
+func ConfigFilePath(homeDir string) (string, error) {
+	return utils.FindFile(homeDir, ConfigFileName, configFileExts...)
+}
+
+// LoadConfigFile updates the Options with the values of the TOML, YAML or JSON configuration file at the path.
+// The format is chosen by the file extension. Keys missing from the file are left unchanged.
+func (o *Options) LoadConfigFile(path string) error {
+	return utils.DecodeFile(path, o)
+}
+
+// WriteConfigFile writes the Options to the configuration file at the path,
+// in the TOML, YAML or JSON format chosen by the file extension.
+func (o *Options) WriteConfigFile(path string) error {
+	m, err := o.ConfigMap()
+	if err != nil {
+		return err
+	}
+
+	var buf []byte
+	switch ext := filepath.Ext(path); ext {
+	case ".toml":
+		buf, err = toml.Marshal(m)
+	case ".yaml", ".yml":
+		buf, err = yaml.Marshal(m)
+	case ".json":
+		buf, err = json.MarshalIndent(m, "", "  ")
+	default:
+		return fmt.Errorf("unsupported config file extension %s", ext)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf, 0600)
+}
+
+// jsonName returns the name of a struct field in its json tag, or an empty string if it has none.
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+
+	return name
+}
+
+// ConfigKeys returns the keys of every configurable option, in the form "section.field".
+func (o *Options) ConfigKeys() []string {
+	var keys []string
+
+	t := reflect.TypeOf(o).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		section := t.Field(i)
+		for j := 0; j < section.Type.Elem().NumField(); j++ {
+			if name := jsonName(section.Type.Elem().Field(j)); name != "" {
+				keys = append(keys, jsonName(section)+"."+name)
+			}
+		}
+	}
+
+	return keys
+}
+
+// ConfigMap returns the configurable options as a map of sections, each holding a map of field values.
+func (o *Options) ConfigMap() (map[string]map[string]interface{}, error) {
+	m := make(map[string]map[string]interface{})
+	for _, key := range o.ConfigKeys() {
+		v, err := o.configField(key)
+		if err != nil {
+			return nil, err
+		}
+
+		section, field, _ := strings.Cut(key, ".")
+		if m[section] == nil {
+			m[section] = make(map[string]interface{})
+		}
+
+		m[section][field] = v.Interface()
+	}
+
+	return m, nil
+}
+
+// configField returns the field of the option with the given key, allocating its section if needed.
+func (o *Options) configField(key string) (reflect.Value, error) {
+	sectionName, fieldName, ok := strings.Cut(key, ".")
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("invalid config key %s", key)
+	}
+
+	v := reflect.ValueOf(o).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if jsonName(v.Type().Field(i)) != sectionName {
+			continue
+		}
+
+		section := v.Field(i)
+		if section.IsNil() {
+			section.Set(reflect.New(section.Type().Elem()))
+		}
+
+		section = section.Elem()
+		for j := 0; j < section.NumField(); j++ {
+			if jsonName(section.Type().Field(j)) == fieldName {
+				return section.Field(j), nil
+			}
+		}
+	}
+
+	return reflect.Value{}, fmt.Errorf("unknown config key %s", key)
+}
+
+// ConfigValue returns the value of the option with the given key, formatted as a string.
+func (o *Options) ConfigValue(key string) (string, error) {
+	v, err := o.configField(key)
+	if err != nil {
+		return "", err
+	}
+
+	if v.Kind() == reflect.Slice {
+		return strings.Join(v.Interface().([]string), ","), nil
+	}
+
+	return fmt.Sprint(v.Interface()), nil
+}
+
+// SetConfigValue parses the string value and sets it as the option with the given key.
+func (o *Options) SetConfigValue(key, value string) error {
+	v, err := o.configField(key)
+	if err != nil {
+		return err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", key, err)
+		}
+
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", key, err)
+		}
+
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", key, err)
+		}
+
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(value, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", key, err)
+		}
+
+		v.SetFloat(n)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s of config key %s", v.Type(), key)
+		}
+
+		// Lists are given as comma-separated values.
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %s of config key %s", v.Type(), key)
+	}
+
+	return nil
+}
+
+// ConfigEnvName returns the name of the environment variable overriding the option with the given key.
+// For example, the key "query.rpc_addr" is overridden by SENTINEL_QUERY_RPC_ADDR.
+func ConfigEnvName(key string) string {
+	return EnvPrefix + "_" + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(key))
+}
+
+// ApplyEnv overrides the options with the values of the environment variables that are set.
+func (o *Options) ApplyEnv() error {
+	for _, key := range o.ConfigKeys() {
+		if v, ok := os.LookupEnv(ConfigEnvName(key)); ok {
+			if err := o.SetConfigValue(key, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ApplyFlags overrides the options with the values of the command flags that were set explicitly.
+// A flag such as "query.rpc-addr" overrides the option with the key "query.rpc_addr".
+func (o *Options) ApplyFlags(cmd *cobra.Command) error {
+	for _, key := range o.ConfigKeys() {
+		name := strings.ReplaceAll(key, "_", "-")
+		f := cmd.Flags().Lookup(name)
+		if f == nil || !f.Changed {
+			continue
+		}
+
+		// List flags are formatted as "[a,b]", so their items are joined instead.
+		value := f.Value.String()
+		if v, ok := f.Value.(pflag.SliceValue); ok {
+			value = strings.Join(v.GetSlice(), ",")
+		}
+
+		if err := o.SetConfigValue(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,257 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// newTestCmd returns a command with the home, network, query and tx flags, parsed from the arguments.
+func newTestCmd(t *testing.T, args ...string) *cobra.Command {
+	t.Helper()
+
+	cmd := &cobra.Command{Use: "test"}
+	flags.SetPersistentFlagHomeDir(cmd)
+	flags.SetPersistentFlagNetwork(cmd)
+	flags.AddQueryFlags(cmd)
+	flags.AddTxFlags(cmd)
+
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatal(err)
+	}
+
+	return cmd
+}
+
+func TestNewFromCmd(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		networks string
+		env      map[string]string
+		args     []string
+		key      string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name: "default",
+			key:  "query.rpc_addr",
+			want: flags.DefaultQueryRPCAddr,
+		},
+		{
+			name: "config file over default",
+			file: "[query]\nrpc_addr = \"https://file:443\"\n",
+			key:  "query.rpc_addr",
+			want: "https://file:443",
+		},
+		{
+			name: "env over config file",
+			file: "[query]\nrpc_addr = \"https://file:443\"\n",
+			env:  map[string]string{"SENTINEL_QUERY_RPC_ADDR": "https://env:443"},
+			key:  "query.rpc_addr",
+			want: "https://env:443",
+		},
+		{
+			name: "flag over env and config file",
+			file: "[query]\nrpc_addr = \"https://file:443\"\n",
+			env:  map[string]string{"SENTINEL_QUERY_RPC_ADDR": "https://env:443"},
+			args: []string{"--query.rpc-addr", "https://flag:443"},
+			key:  "query.rpc_addr",
+			want: "https://flag:443",
+		},
+		{
+			name: "unchanged flag does not override",
+			file: "[tx]\nchain_id = \"file-1\"\n",
+			args: []string{"--query.rpc-addr", "https://flag:443"},
+			key:  "tx.chain_id",
+			want: "file-1",
+		},
+		{
+			name: "config file keeps other defaults",
+			file: "[query]\nrpc_addr = \"https://file:443\"\n",
+			key:  "query.max_retries",
+			want: strconv.Itoa(flags.DefaultQueryMaxRetries),
+		},
+		{
+			name: "integer from env",
+			env:  map[string]string{"SENTINEL_QUERY_MAX_RETRIES": "7"},
+			key:  "query.max_retries",
+			want: "7",
+		},
+		{
+			name: "network over config file",
+			file: "[query]\nrpc_addr = \"https://file:443\"\n",
+			args: []string{"--network", "local"},
+			key:  "query.rpc_addr",
+			want: "http://127.0.0.1:26657",
+		},
+		{
+			name: "env over network",
+			env:  map[string]string{"SENTINEL_TX_CHAIN_ID": "env-1"},
+			args: []string{"--network", "local"},
+			key:  "tx.chain_id",
+			want: "env-1",
+		},
+		{
+			name: "network from env",
+			env:  map[string]string{"SENTINEL_NETWORK": "local"},
+			key:  "tx.chain_id",
+			want: "sentinelhub-local",
+		},
+		{
+			name:     "user-defined network",
+			networks: "[devnet]\nchain_id = \"devnet-1\"\nrpc_addrs = [\"http://devnet:26657\"]\n",
+			args:     []string{"--network", "devnet"},
+			key:      "tx.chain_id",
+			want:     "devnet-1",
+		},
+		{
+			name:     "network fallback rpc servers",
+			networks: "[devnet]\nchain_id = \"devnet-1\"\nrpc_addrs = [\"http://a:26657\", \"http://b:26657\", \"http://c:26657\"]\n",
+			args:     []string{"--network", "devnet"},
+			key:      "query.rpc_addrs",
+			want:     "http://b:26657,http://c:26657",
+		},
+		{
+			name:     "network gas prices in denom",
+			networks: "[devnet]\nchain_id = \"devnet-1\"\nrpc_addrs = [\"http://devnet:26657\"]\ngas_prices = \"0.25\"\ndenom = \"ufoo\"\n",
+			args:     []string{"--network", "devnet"},
+			key:      "tx.gas_prices",
+			want:     "0.25ufoo",
+		},
+		{
+			name:     "network gas prices in another denom",
+			networks: "[devnet]\nchain_id = \"devnet-1\"\nrpc_addrs = [\"http://devnet:26657\"]\ngas_prices = \"0.25ubar\"\ndenom = \"ufoo\"\n",
+			args:     []string{"--network", "devnet"},
+			wantErr:  true,
+		},
+		{
+			name:     "network without rpc servers",
+			networks: "[devnet]\nchain_id = \"devnet-1\"\n",
+			args:     []string{"--network", "devnet"},
+			wantErr:  true,
+		},
+		{
+			name: "fallback rpc servers from flag",
+			args: []string{"--query.rpc-addrs", "http://a:26657,http://b:26657"},
+			key:  "query.rpc_addrs",
+			want: "http://a:26657,http://b:26657",
+		},
+		{
+			name:    "unknown network",
+			args:    []string{"--network", "unknown"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid integer from env",
+			env:     map[string]string{"SENTINEL_QUERY_MAX_RETRIES": "seven"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid config file",
+			file:    "[query\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			homeDir := t.TempDir()
+			t.Setenv(EnvPrefix+"_HOME", homeDir)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			if tt.file != "" {
+				if err := os.WriteFile(filepath.Join(homeDir, ConfigFileName+".toml"), []byte(tt.file), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if tt.networks != "" {
+				if err := os.WriteFile(filepath.Join(homeDir, NetworksFileName+".toml"), []byte(tt.networks), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			opts, err := NewFromCmd(newTestCmd(t, tt.args...))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewFromCmd() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := opts.ConfigValue(tt.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("%s = %s, want %s", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptions_SetConfigValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"string", "tx.memo", "hello", "hello", false},
+		{"bool", "query.prove", "true", "true", false},
+		{"float", "tx.gas_adjustment", "1.5", "1.5", false},
+		{"list", "query.rpc_addrs", "http://a:26657, http://b:26657", "http://a:26657,http://b:26657", false},
+		{"empty list", "query.rpc_addrs", "", "", false},
+		{"invalid bool", "query.prove", "maybe", "", true},
+		{"unknown field", "query.unknown", "1", "", true},
+		{"unknown section", "unknown.rpc_addr", "1", "", true},
+		{"missing section", "rpc_addr", "1", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := NewDefaultOptions()
+
+			err := opts.SetConfigValue(tt.key, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetConfigValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, _ := opts.ConfigValue(tt.key); got != tt.want {
+				t.Errorf("ConfigValue() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigEnvName(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"query.rpc_addr", "SENTINEL_QUERY_RPC_ADDR"},
+		{"tx.gas-prices", "SENTINEL_TX_GAS_PRICES"},
+		{"keyring.backend", "SENTINEL_KEYRING_BACKEND"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := ConfigEnvName(tt.key); got != tt.want {
+				t.Errorf("ConfigEnvName() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
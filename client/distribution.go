@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+const (
+	// gRPC methods for querying distribution information
+	methodQueryDelegationRewards      = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
+	methodQueryDelegationTotalRewards = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
+	methodQueryValidatorCommission    = "/cosmos.distribution.v1beta1.Query/ValidatorCommission"
+)
+
+// DelegationRewards queries and returns the rewards of an account accrued from a specific validator.
+// It uses gRPC to send a request to the "/cosmos.distribution.v1beta1.Query/DelegationRewards" endpoint.
+// The result is a cosmossdk.DecCoins and an error if the query fails.
+func (c *Client) DelegationRewards(ctx context.Context, accAddr cosmossdk.AccAddress, valAddr cosmossdk.ValAddress, opts *Options) (res cosmossdk.DecCoins, err error) {
+	// Initialize variables for the query.
+	var (
+		resp distributiontypes.QueryDelegationRewardsResponse
+		req  = &distributiontypes.QueryDelegationRewardsRequest{
+			DelegatorAddress: accAddr.String(),
+			ValidatorAddress: valAddr.String(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryDelegationRewards, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the rewards and a nil error.
+	return resp.Rewards, nil
+}
+
+// DelegationTotalRewards queries and returns the rewards of an account accrued from each of its validators.
+// It uses gRPC to send a request to the "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards" endpoint.
+// The result is a pointer to distributiontypes.QueryDelegationTotalRewardsResponse, holding the
+// rewards per validator and their total, and an error if the query fails.
+func (c *Client) DelegationTotalRewards(ctx context.Context, accAddr cosmossdk.AccAddress, opts *Options) (res *distributiontypes.QueryDelegationTotalRewardsResponse, err error) {
+	// Initialize variables for the query.
+	var (
+		resp distributiontypes.QueryDelegationTotalRewardsResponse
+		req  = &distributiontypes.QueryDelegationTotalRewardsRequest{
+			DelegatorAddress: accAddr.String(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryDelegationTotalRewards, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return a pointer to the rewards and a nil error.
+	return &resp, nil
+}
+
+// ValidatorCommission queries and returns the accumulated commission of a specific validator.
+// It uses gRPC to send a request to the "/cosmos.distribution.v1beta1.Query/ValidatorCommission" endpoint.
+// The result is a cosmossdk.DecCoins and an error if the query fails.
+func (c *Client) ValidatorCommission(ctx context.Context, valAddr cosmossdk.ValAddress, opts *Options) (res cosmossdk.DecCoins, err error) {
+	// Initialize variables for the query.
+	var (
+		resp distributiontypes.QueryValidatorCommissionResponse
+		req  = &distributiontypes.QueryValidatorCommissionRequest{
+			ValidatorAddress: valAddr.String(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryValidatorCommission, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the commission and a nil error.
+	return resp.Commission.Commission, nil
+}
+
+// WithdrawRewards withdraws the rewards of the account of the signing key from the given validators.
+// If no validators are given, rewards are withdrawn from every validator with outstanding rewards.
+// It broadcasts the transaction and returns the broadcast result and an error, if any.
+func (c *Client) WithdrawRewards(ctx context.Context, valAddrs []cosmossdk.ValAddress, opts *Options) (*coretypes.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the validators with outstanding rewards if none are given.
+	if len(valAddrs) == 0 {
+		rewards, err := c.DelegationTotalRewards(ctx, accAddr, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range rewards.Rewards {
+			if item.Reward.IsZero() {
+				continue
+			}
+
+			valAddr, err := cosmossdk.ValAddressFromBech32(item.ValidatorAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			valAddrs = append(valAddrs, valAddr)
+		}
+	}
+
+	if len(valAddrs) == 0 {
+		return nil, errors.New("no rewards to withdraw")
+	}
+
+	// Build one withdraw message per validator.
+	msgs := make([]cosmossdk.Msg, 0, len(valAddrs))
+	for _, valAddr := range valAddrs {
+		msgs = append(msgs, distributiontypes.NewMsgWithdrawDelegatorReward(accAddr, valAddr))
+	}
+
+	return c.BroadcastTx(ctx, msgs, opts)
+}
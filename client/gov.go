@@ -0,0 +1,209 @@
+package client
+
+import (
+	"context"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+const (
+	// gRPC methods for querying governance information
+	methodQueryProposal         = "/cosmos.gov.v1.Query/Proposal"
+	methodQueryProposals        = "/cosmos.gov.v1.Query/Proposals"
+	methodQueryProposalVote     = "/cosmos.gov.v1.Query/Vote"
+	methodQueryProposalVotes    = "/cosmos.gov.v1.Query/Votes"
+	methodQueryProposalDeposit  = "/cosmos.gov.v1.Query/Deposit"
+	methodQueryProposalDeposits = "/cosmos.gov.v1.Query/Deposits"
+	methodQueryProposalTally    = "/cosmos.gov.v1.Query/TallyResult"
+)
+
+// Proposal queries and returns information about a specific proposal based on the provided proposal ID.
+// It uses gRPC to send a request to the "/cosmos.gov.v1.Query/Proposal" endpoint.
+// The result is a pointer to govv1.Proposal and an error if the query fails.
+func (c *Client) Proposal(ctx context.Context, id uint64, opts *Options) (res *govv1.Proposal, err error) {
+	// Initialize variables for the query.
+	var (
+		resp govv1.QueryProposalResponse
+		req  = &govv1.QueryProposalRequest{
+			ProposalId: id,
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryProposal, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the proposal and a nil error.
+	return resp.Proposal, nil
+}
+
+// Proposals queries and returns a list of proposals based on the provided status and options.
+// An unspecified status returns proposals of any status.
+// It uses gRPC to send a request to the "/cosmos.gov.v1.Query/Proposals" endpoint.
+// The result is a slice of govv1.Proposal and an error if the query fails.
+func (c *Client) Proposals(ctx context.Context, status govv1.ProposalStatus, opts *Options) (res []*govv1.Proposal, err error) {
+	// Initialize variables for the query.
+	var (
+		resp govv1.QueryProposalsResponse
+		req  = &govv1.QueryProposalsRequest{
+			ProposalStatus: status,
+			Pagination:     opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryProposals, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the list of proposals and a nil error.
+	return resp.Proposals, nil
+}
+
+// ProposalVote queries and returns the vote of a specific account on a proposal.
+// It uses gRPC to send a request to the "/cosmos.gov.v1.Query/Vote" endpoint.
+// The result is a pointer to govv1.Vote and an error if the query fails.
+func (c *Client) ProposalVote(ctx context.Context, id uint64, accAddr cosmossdk.AccAddress, opts *Options) (res *govv1.Vote, err error) {
+	// Initialize variables for the query.
+	var (
+		resp govv1.QueryVoteResponse
+		req  = &govv1.QueryVoteRequest{
+			ProposalId: id,
+			Voter:      accAddr.String(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryProposalVote, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the vote and a nil error.
+	return resp.Vote, nil
+}
+
+// ProposalVotes queries and returns a list of votes on a specific proposal.
+// It uses gRPC to send a request to the "/cosmos.gov.v1.Query/Votes" endpoint.
+// The result is a slice of govv1.Vote and an error if the query fails.
+func (c *Client) ProposalVotes(ctx context.Context, id uint64, opts *Options) (res []*govv1.Vote, err error) {
+	// Initialize variables for the query.
+	var (
+		resp govv1.QueryVotesResponse
+		req  = &govv1.QueryVotesRequest{
+			ProposalId: id,
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryProposalVotes, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the list of votes and a nil error.
+	return resp.Votes, nil
+}
+
+// ProposalDeposit queries and returns the deposit of a specific account on a proposal.
+// It uses gRPC to send a request to the "/cosmos.gov.v1.Query/Deposit" endpoint.
+// The result is a pointer to govv1.Deposit and an error if the query fails.
+func (c *Client) ProposalDeposit(ctx context.Context, id uint64, accAddr cosmossdk.AccAddress, opts *Options) (res *govv1.Deposit, err error) {
+	// Initialize variables for the query.
+	var (
+		resp govv1.QueryDepositResponse
+		req  = &govv1.QueryDepositRequest{
+			ProposalId: id,
+			Depositor:  accAddr.String(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryProposalDeposit, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the deposit and a nil error.
+	return resp.Deposit, nil
+}
+
+// ProposalDeposits queries and returns a list of deposits on a specific proposal.
+// It uses gRPC to send a request to the "/cosmos.gov.v1.Query/Deposits" endpoint.
+// The result is a slice of govv1.Deposit and an error if the query fails.
+func (c *Client) ProposalDeposits(ctx context.Context, id uint64, opts *Options) (res []*govv1.Deposit, err error) {
+	// Initialize variables for the query.
+	var (
+		resp govv1.QueryDepositsResponse
+		req  = &govv1.QueryDepositsRequest{
+			ProposalId: id,
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryProposalDeposits, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the list of deposits and a nil error.
+	return resp.Deposits, nil
+}
+
+// ProposalTally queries and returns the tally result of a specific proposal.
+// It uses gRPC to send a request to the "/cosmos.gov.v1.Query/TallyResult" endpoint.
+// The result is a pointer to govv1.TallyResult and an error if the query fails.
+func (c *Client) ProposalTally(ctx context.Context, id uint64, opts *Options) (res *govv1.TallyResult, err error) {
+	// Initialize variables for the query.
+	var (
+		resp govv1.QueryTallyResultResponse
+		req  = &govv1.QueryTallyResultRequest{
+			ProposalId: id,
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryProposalTally, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the tally result and a nil error.
+	return resp.Tally, nil
+}
+
+// Vote casts a vote with a single option on a proposal from the account of the signing key.
+// It broadcasts the transaction and returns the broadcast result and an error, if any.
+func (c *Client) Vote(ctx context.Context, id uint64, option govv1.VoteOption, metadata string, opts *Options) (*coretypes.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := govv1.NewMsgVote(accAddr, id, option, metadata)
+	return c.BroadcastTx(ctx, []cosmossdk.Msg{msg}, opts)
+}
+
+// VoteWeighted casts a vote split across weighted options on a proposal from the account of the signing key.
+// It broadcasts the transaction and returns the broadcast result and an error, if any.
+func (c *Client) VoteWeighted(ctx context.Context, id uint64, options govv1.WeightedVoteOptions, metadata string, opts *Options) (*coretypes.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := govv1.NewMsgVoteWeighted(accAddr, id, options, metadata)
+	return c.BroadcastTx(ctx, []cosmossdk.Msg{msg}, opts)
+}
+
+// Deposit deposits the given amount on a proposal from the account of the signing key.
+// It broadcasts the transaction and returns the broadcast result and an error, if any.
+func (c *Client) Deposit(ctx context.Context, id uint64, amount cosmossdk.Coins, opts *Options) (*coretypes.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := govv1.NewMsgDeposit(accAddr, id, amount)
+	return c.BroadcastTx(ctx, []cosmossdk.Msg{msg}, opts)
+}
@@ -0,0 +1,304 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/sentinel-official/sentinel-go-sdk/denoms"
+)
+
+const (
+	// gRPC methods for querying IBC transfer information
+	methodQueryDenomTrace  = "/ibc.applications.transfer.v1.Query/DenomTrace"
+	methodQueryDenomTraces = "/ibc.applications.transfer.v1.Query/DenomTraces"
+
+	// gRPC methods for querying IBC channel information
+	methodQueryChannel               = "/ibc.core.channel.v1.Query/Channel"
+	methodQueryChannels              = "/ibc.core.channel.v1.Query/Channels"
+	methodQueryChannelClientState    = "/ibc.core.channel.v1.Query/ChannelClientState"
+	methodQueryChannelConsensusState = "/ibc.core.channel.v1.Query/ChannelConsensusState"
+)
+
+// DenomTrace queries and returns the denom trace of an IBC denom based on the provided hash.
+// The hash may be given with or without the "ibc/" prefix.
+// It uses gRPC to send a request to the "/ibc.applications.transfer.v1.Query/DenomTrace" endpoint.
+// The result is a pointer to transfertypes.DenomTrace and an error if the query fails.
+func (c *Client) DenomTrace(ctx context.Context, hash string, opts *Options) (res *transfertypes.DenomTrace, err error) {
+	// Initialize variables for the query.
+	var (
+		resp transfertypes.QueryDenomTraceResponse
+		req  = &transfertypes.QueryDenomTraceRequest{
+			Hash: strings.TrimPrefix(hash, transfertypes.DenomPrefix+"/"),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryDenomTrace, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the denom trace and a nil error.
+	return resp.DenomTrace, nil
+}
+
+// DenomTraces queries and returns a list of denom traces based on the provided options.
+// It uses gRPC to send a request to the "/ibc.applications.transfer.v1.Query/DenomTraces" endpoint.
+// The result is a slice of transfertypes.DenomTrace and an error if the query fails.
+func (c *Client) DenomTraces(ctx context.Context, opts *Options) (res []transfertypes.DenomTrace, err error) {
+	// Initialize variables for the query.
+	var (
+		resp transfertypes.QueryDenomTracesResponse
+		req  = &transfertypes.QueryDenomTracesRequest{
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryDenomTraces, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the list of denom traces and a nil error.
+	return resp.DenomTraces, nil
+}
+
+// Channel queries and returns information about a specific channel based on the provided port and channel IDs.
+// It uses gRPC to send a request to the "/ibc.core.channel.v1.Query/Channel" endpoint.
+// The result is a pointer to channeltypes.Channel and an error if the query fails.
+func (c *Client) Channel(ctx context.Context, portID, channelID string, opts *Options) (res *channeltypes.Channel, err error) {
+	// Initialize variables for the query.
+	var (
+		resp channeltypes.QueryChannelResponse
+		req  = &channeltypes.QueryChannelRequest{
+			PortId:    portID,
+			ChannelId: channelID,
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryChannel, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the channel and a nil error.
+	return resp.Channel, nil
+}
+
+// Channels queries and returns a list of channels based on the provided options.
+// It uses gRPC to send a request to the "/ibc.core.channel.v1.Query/Channels" endpoint.
+// The result is a slice of channeltypes.IdentifiedChannel and an error if the query fails.
+func (c *Client) Channels(ctx context.Context, opts *Options) (res []*channeltypes.IdentifiedChannel, err error) {
+	// Initialize variables for the query.
+	var (
+		resp channeltypes.QueryChannelsResponse
+		req  = &channeltypes.QueryChannelsRequest{
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryChannels, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the list of channels and a nil error.
+	return resp.Channels, nil
+}
+
+// ChannelClientState queries and returns the state of the light client of the counterparty chain
+// associated with the provided port and channel IDs.
+// It uses gRPC to send a request to the "/ibc.core.channel.v1.Query/ChannelClientState" endpoint.
+// The result is an exported.ClientState and an error if the query fails.
+func (c *Client) ChannelClientState(ctx context.Context, portID, channelID string, opts *Options) (res exported.ClientState, err error) {
+	// Initialize variables for the query.
+	var (
+		resp channeltypes.QueryChannelClientStateResponse
+		req  = &channeltypes.QueryChannelClientStateRequest{
+			PortId:    portID,
+			ChannelId: channelID,
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryChannelClientState, req, &resp, opts); err != nil {
+		return nil, err
+	}
+	if resp.IdentifiedClientState == nil {
+		return nil, fmt.Errorf("client state for channel %s/%s does not exist", portID, channelID)
+	}
+
+	// Unpack the client state and return it and a nil error.
+	if err := c.UnpackAny(resp.IdentifiedClientState.ClientState, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ChannelConsensusState queries and returns the consensus state of the counterparty chain at the given height,
+// as stored by the light client associated with the provided port and channel IDs.
+// It uses gRPC to send a request to the "/ibc.core.channel.v1.Query/ChannelConsensusState" endpoint.
+// The result is an exported.ConsensusState and an error if the query fails.
+func (c *Client) ChannelConsensusState(ctx context.Context, portID, channelID string, height clienttypes.Height, opts *Options) (res exported.ConsensusState, err error) {
+	// Initialize variables for the query.
+	var (
+		resp channeltypes.QueryChannelConsensusStateResponse
+		req  = &channeltypes.QueryChannelConsensusStateRequest{
+			PortId:         portID,
+			ChannelId:      channelID,
+			RevisionNumber: height.GetRevisionNumber(),
+			RevisionHeight: height.GetRevisionHeight(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryChannelConsensusState, req, &resp, opts); err != nil {
+		return nil, err
+	}
+	if resp.ConsensusState == nil {
+		return nil, fmt.Errorf("consensus state for channel %s/%s at height %s does not exist", portID, channelID, height)
+	}
+
+	// Unpack the consensus state and return it and a nil error.
+	if err := c.UnpackAny(resp.ConsensusState, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// TransferTimeout calculates the timeout height and timestamp of a packet sent over the given channel.
+// Both are relative to the latest state of the counterparty chain known to the channel's light client:
+// the timeout height is its latest height plus the given number of blocks, and the timeout timestamp is
+// the block time at that height plus the given duration. Using the counterparty's clock rather than the
+// local one keeps the packet from timing out early when the local clock is ahead.
+// A zero value for either disables the corresponding timeout.
+func (c *Client) TransferTimeout(ctx context.Context, portID, channelID string, blocks uint64, duration time.Duration, opts *Options) (clienttypes.Height, uint64, error) {
+	var (
+		height    clienttypes.Height
+		timestamp uint64
+	)
+
+	if blocks == 0 && duration == 0 {
+		return height, timestamp, nil
+	}
+
+	// Find the latest height of the counterparty chain known to the light client
+	clientState, err := c.ChannelClientState(ctx, portID, channelID, opts)
+	if err != nil {
+		return height, 0, err
+	}
+
+	latest, ok := clientState.GetLatestHeight().(clienttypes.Height)
+	if !ok {
+		return height, 0, fmt.Errorf("invalid height type %T", clientState.GetLatestHeight())
+	}
+
+	if blocks > 0 {
+		height = clienttypes.NewHeight(latest.GetRevisionNumber(), latest.GetRevisionHeight()+blocks)
+	}
+
+	if duration > 0 {
+		// The consensus state at the latest height holds the block time of the counterparty chain
+		consensusState, err := c.ChannelConsensusState(ctx, portID, channelID, latest, opts)
+		if err != nil {
+			return height, 0, err
+		}
+
+		timestamp = consensusState.GetTimestamp() + uint64(duration.Nanoseconds())
+	}
+
+	return height, timestamp, nil
+}
+
+// Transfer sends tokens from the account of the signing key to a receiver on the counterparty chain
+// of the given channel. The packet times out after the default number of blocks or the default
+// duration of the transfer module, whichever comes first.
+// It broadcasts the transaction and returns the broadcast result and an error, if any.
+func (c *Client) Transfer(ctx context.Context, portID, channelID string, token cosmossdk.Coin, receiver, memo string, opts *Options) (*coretypes.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the default relative timeouts of the transfer module
+	relativeHeight, err := clienttypes.ParseHeight(transfertypes.DefaultRelativePacketTimeoutHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	relativeDuration := time.Duration(transfertypes.DefaultRelativePacketTimeoutTimestamp)
+
+	// Calculate the absolute timeouts of the packet
+	timeoutHeight, timeoutTimestamp, err := c.TransferTimeout(ctx, portID, channelID, relativeHeight.GetRevisionHeight(), relativeDuration, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := transfertypes.NewMsgTransfer(portID, channelID, token, accAddr.String(), receiver, timeoutHeight, timeoutTimestamp, memo)
+	return c.BroadcastTx(ctx, []cosmossdk.Msg{msg}, opts)
+}
+
+// ResolveDenom returns the denom trace of the given denom, which holds its readable base denom and
+// the channel path it was transferred through. Denoms without the "ibc/" prefix are native and
+// returned as a trace without a path. Resolved traces are cached, as they never change.
+func (c *Client) ResolveDenom(ctx context.Context, denom string, opts *Options) (transfertypes.DenomTrace, error) {
+	if !strings.HasPrefix(denom, transfertypes.DenomPrefix+"/") {
+		return transfertypes.DenomTrace{BaseDenom: denom}, nil
+	}
+
+	// Return a previously resolved trace.
+	c.Lock()
+	trace, ok := c.denomTraces[denom]
+	c.Unlock()
+	if ok {
+		return trace, nil
+	}
+
+	res, err := c.DenomTrace(ctx, denom, opts)
+	if err != nil {
+		return transfertypes.DenomTrace{}, err
+	}
+	if res == nil {
+		return transfertypes.DenomTrace{}, fmt.Errorf("denom trace for %s does not exist", denom)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.denomTraces == nil {
+		c.denomTraces = make(map[string]transfertypes.DenomTrace)
+	}
+
+	c.denomTraces[denom] = *res
+	return *res, nil
+}
+
+// RegisterDenomTraces resolves the given IBC denoms and records their base denoms in the registry, so their
+// amounts are shown with readable names. Denoms without the "ibc/" prefix are skipped.
+func (c *Client) RegisterDenomTraces(ctx context.Context, r *denoms.Registry, items []string, opts *Options) error {
+	for _, denom := range items {
+		if !strings.HasPrefix(denom, transfertypes.DenomPrefix+"/") {
+			continue
+		}
+
+		trace, err := c.ResolveDenom(ctx, denom, opts)
+		if err != nil {
+			return err
+		}
+		if err := r.RegisterTrace(denom, trace.BaseDenom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
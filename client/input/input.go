@@ -42,12 +42,7 @@ func GetConfirmation(prompt string, buf *bufio.Reader) (bool, error) {
 		return false, err
 	}
 
-	line = strings.ToLower(line)
-	if len(line) > 0 && line[0] == 'y' {
-		return true, nil
-	}
-
-	return false, nil
+	return parseConfirmation(line), nil
 }
 
 // GetPassword prompts the user for a password. If the standard input is a terminal, use a secure prompt.
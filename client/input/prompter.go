@@ -0,0 +1,231 @@
+package input
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bgentry/speakeasy"
+	"github.com/mattn/go-isatty"
+)
+
+// Prompter prompts the user for strings, passwords and confirmations.
+type Prompter interface {
+	GetConfirmation(prompt string) (bool, error)
+	GetPassword(prompt string) (string, error)
+	GetString(prompt string) (string, error)
+}
+
+var (
+	_ Prompter = (*CallbackPrompter)(nil)
+	_ Prompter = (*ScriptedPrompter)(nil)
+	_ Prompter = (*TerminalPrompter)(nil)
+)
+
+// parseConfirmation returns true if the response starts with 'y' or 'Y'.
+func parseConfirmation(line string) bool {
+	line = strings.ToLower(line)
+	return len(line) > 0 && line[0] == 'y'
+}
+
+// TerminalPrompter reads responses line by line from an input, writing prompts to an output when the
+// input is a terminal. Passwords typed in a terminal are not echoed.
+type TerminalPrompter struct {
+	buf      *bufio.Reader // buf buffers the input, so responses are read line by line.
+	out      io.Writer     // out is where prompts are written.
+	terminal bool          // terminal indicates whether the input is a terminal.
+}
+
+// NewTerminalPrompter creates a new TerminalPrompter reading from in and writing prompts to out.
+func NewTerminalPrompter(in io.Reader, out io.Writer) *TerminalPrompter {
+	terminal := false
+	if f, ok := in.(*os.File); ok {
+		terminal = isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+	}
+
+	return &TerminalPrompter{
+		buf:      bufio.NewReader(in),
+		out:      out,
+		terminal: terminal,
+	}
+}
+
+// GetConfirmation prompts with a message and returns true if the response starts with 'y' or 'Y'.
+func (p *TerminalPrompter) GetConfirmation(prompt string) (bool, error) {
+	line, err := p.GetString(prompt)
+	if err != nil {
+		return false, err
+	}
+
+	return parseConfirmation(line), nil
+}
+
+// GetPassword prompts for a password. If the input is a terminal, a secure prompt reading the standard input is used.
+func (p *TerminalPrompter) GetPassword(prompt string) (string, error) {
+	if prompt != "" && p.terminal {
+		return speakeasy.FAsk(p.out, prompt)
+	}
+
+	return readLineFromBuf(p.buf)
+}
+
+// GetString prompts with a message and reads a line from the input.
+func (p *TerminalPrompter) GetString(prompt string) (string, error) {
+	if prompt != "" && p.terminal {
+		_, _ = fmt.Fprint(p.out, prompt)
+	}
+
+	return readLineFromBuf(p.buf)
+}
+
+// ScriptedPrompter answers prompts with a predefined list of responses, in order.
+// It is useful for tests and non-interactive flows.
+type ScriptedPrompter struct {
+	answers []string // answers are the responses not yet used.
+}
+
+// NewScriptedPrompter creates a new ScriptedPrompter answering prompts with the given responses, in order.
+func NewScriptedPrompter(answers ...string) *ScriptedPrompter {
+	return &ScriptedPrompter{
+		answers: answers,
+	}
+}
+
+// next returns the next response, or an error if none is left.
+func (p *ScriptedPrompter) next(prompt string) (string, error) {
+	if len(p.answers) == 0 {
+		return "", fmt.Errorf("no answer left for prompt %q", strings.TrimSpace(prompt))
+	}
+
+	v := p.answers[0]
+	p.answers = p.answers[1:]
+
+	return v, nil
+}
+
+// GetConfirmation returns true if the next response starts with 'y' or 'Y'.
+func (p *ScriptedPrompter) GetConfirmation(prompt string) (bool, error) {
+	line, err := p.next(prompt)
+	if err != nil {
+		return false, err
+	}
+
+	return parseConfirmation(line), nil
+}
+
+// GetPassword returns the next response.
+func (p *ScriptedPrompter) GetPassword(prompt string) (string, error) {
+	return p.next(prompt)
+}
+
+// GetString returns the next response.
+func (p *ScriptedPrompter) GetString(prompt string) (string, error) {
+	return p.next(prompt)
+}
+
+// CallbackPrompter answers prompts by calling functions, such as those of a GUI dialog.
+// Prompts of a kind without a function fail.
+type CallbackPrompter struct {
+	confirmationFunc func(prompt string) (bool, error)   // confirmationFunc answers confirmation prompts.
+	passwordFunc     func(prompt string) (string, error) // passwordFunc answers password prompts.
+	stringFunc       func(prompt string) (string, error) // stringFunc answers string prompts.
+}
+
+// NewCallbackPrompter creates a new CallbackPrompter without any function.
+func NewCallbackPrompter() *CallbackPrompter {
+	return &CallbackPrompter{}
+}
+
+// WithConfirmationFunc sets the function answering confirmation prompts and returns the updated CallbackPrompter.
+func (p *CallbackPrompter) WithConfirmationFunc(v func(prompt string) (bool, error)) *CallbackPrompter {
+	p.confirmationFunc = v
+	return p
+}
+
+// WithPasswordFunc sets the function answering password prompts and returns the updated CallbackPrompter.
+func (p *CallbackPrompter) WithPasswordFunc(v func(prompt string) (string, error)) *CallbackPrompter {
+	p.passwordFunc = v
+	return p
+}
+
+// WithStringFunc sets the function answering string prompts and returns the updated CallbackPrompter.
+func (p *CallbackPrompter) WithStringFunc(v func(prompt string) (string, error)) *CallbackPrompter {
+	p.stringFunc = v
+	return p
+}
+
+// GetConfirmation answers the prompt with the confirmation function.
+func (p *CallbackPrompter) GetConfirmation(prompt string) (bool, error) {
+	if p.confirmationFunc == nil {
+		return false, errors.New("confirmation prompts are not supported")
+	}
+
+	return p.confirmationFunc(prompt)
+}
+
+// GetPassword answers the prompt with the password function.
+func (p *CallbackPrompter) GetPassword(prompt string) (string, error) {
+	if p.passwordFunc == nil {
+		return "", errors.New("password prompts are not supported")
+	}
+
+	return p.passwordFunc(prompt)
+}
+
+// GetString answers the prompt with the string function.
+func (p *CallbackPrompter) GetString(prompt string) (string, error) {
+	if p.stringFunc == nil {
+		return "", errors.New("string prompts are not supported")
+	}
+
+	return p.stringFunc(prompt)
+}
+
+// passwordReader is an io.Reader yielding a line with a password from a Prompter each time its buffer is drained.
+type passwordReader struct {
+	buf      []byte   // buf holds the part of the line not yet read.
+	count    int      // count is the number of passwords prompted for.
+	prompts  []string // prompts are the messages of the password prompts, used in turn.
+	prompter Prompter // prompter is asked for the passwords.
+}
+
+// NewPasswordReader returns an io.Reader prompting for a password with the Prompter whenever a line is read.
+// The prompts are used in turn, starting over after the last one, so a passphrase and its confirmation can be
+// prompted for with different messages. It adapts a Prompter to APIs reading passwords line by line, such as
+// the passphrase-protected keyring backends.
+func NewPasswordReader(p Prompter, prompts ...string) io.Reader {
+	return &passwordReader{
+		prompts:  prompts,
+		prompter: p,
+	}
+}
+
+// prompt returns the message of the next password prompt.
+func (r *passwordReader) prompt() string {
+	if len(r.prompts) == 0 {
+		return ""
+	}
+
+	return r.prompts[r.count%len(r.prompts)]
+}
+
+// Read reads the current line, prompting for a new password when it is exhausted.
+func (r *passwordReader) Read(p []byte) (int, error) {
+	if len(r.buf) == 0 {
+		v, err := r.prompter.GetPassword(r.prompt())
+		if err != nil {
+			return 0, err
+		}
+
+		r.count++
+		r.buf = []byte(v + "\n")
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
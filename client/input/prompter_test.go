@@ -0,0 +1,46 @@
+package input
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPasswordReader_Read(t *testing.T) {
+	tests := []struct {
+		name    string
+		prompts []string
+		want    []string
+	}{
+		{"no prompt", nil, []string{"", "", ""}},
+		{"one prompt", []string{"Enter:"}, []string{"Enter:", "Enter:", "Enter:"}},
+		{"prompt and confirmation", []string{"Enter:", "Re-enter:"}, []string{"Enter:", "Re-enter:", "Enter:"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var prompts []string
+			p := NewCallbackPrompter().WithPasswordFunc(func(prompt string) (string, error) {
+				prompts = append(prompts, prompt)
+				return "password", nil
+			})
+
+			r := NewPasswordReader(p, tt.prompts...)
+			for range tt.want {
+				// Each line is read with a new buffer, as the keyring backends do.
+				line, err := bufio.NewReader(r).ReadString('\n')
+				if err != nil {
+					t.Fatal(err)
+				}
+				if strings.TrimSpace(line) != "password" {
+					t.Errorf("line = %q, want password", line)
+				}
+			}
+
+			if !reflect.DeepEqual(prompts, tt.want) {
+				t.Errorf("prompts = %q, want %q", prompts, tt.want)
+			}
+		})
+	}
+}
@@ -1,9 +1,21 @@
 package client
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/go-bip39"
+
+	"github.com/sentinel-official/sentinel-go-sdk/libs/shamir"
+	"github.com/sentinel-official/sentinel-go-sdk/options"
 )
 
 // Key retrieves key information from the keyring based on the provided name and options.
@@ -19,6 +31,19 @@ func (c *Client) Key(name string, opts *Options) (*keyring.Record, error) {
 	return kr.Key(name)
 }
 
+// FromAddr retrieves the account address of the key used to sign transactions.
+// It looks up the key named by the FromName field of the options.
+func (c *Client) FromAddr(opts *Options) (cosmossdk.AccAddress, error) {
+	// Retrieve the signing key from the keyring.
+	key, err := c.Key(opts.FromName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the address of the key.
+	return key.GetAddress()
+}
+
 // Sign signs the provided data using the key from the keyring specified by the name and options.
 // It initializes a keyring, retrieves the key, and signs the data.
 func (c *Client) Sign(name string, buf []byte, opts *Options) ([]byte, cryptotypes.PubKey, error) {
@@ -58,11 +83,25 @@ func (c *Client) DeleteKey(name string, opts *Options) error {
 	return kr.Delete(name)
 }
 
-// NewMnemonic generates a new mnemonic phrase using bip39 with 256 bits of entropy.
-// It returns the generated mnemonic or an error if one occurs.
-func (c *Client) NewMnemonic() (string, error) {
+// defaultMnemonicWords is the number of words of a mnemonic generated when creating a key.
+const defaultMnemonicWords = 24
+
+// NewMnemonic generates a new bip39 mnemonic phrase with the given number of words.
+// Only 12 words (128 bits of entropy) and 24 words (256 bits of entropy) are supported.
+func (c *Client) NewMnemonic(words int) (string, error) {
+	// Map the number of words to the bits of entropy.
+	var bitSize int
+	switch words {
+	case 12:
+		bitSize = 128
+	case 24:
+		bitSize = 256
+	default:
+		return "", fmt.Errorf("invalid mnemonic word count %d, must be 12 or 24", words)
+	}
+
 	// Generate new entropy for the mnemonic.
-	entropy, err := bip39.NewEntropy(256)
+	entropy, err := bip39.NewEntropy(bitSize)
 	if err != nil {
 		return "", err
 	}
@@ -76,6 +115,76 @@ func (c *Client) NewMnemonic() (string, error) {
 	return mnemonic, nil
 }
 
+// mnemonicEntropy returns the entropy encoded by a valid bip39 mnemonic, without its checksum bits.
+func mnemonicEntropy(mnemonic string) ([]byte, error) {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, errors.New("invalid mnemonic")
+	}
+
+	// Each word encodes 11 bits, of which one bit in 33 is checksum.
+	words := strings.Fields(mnemonic)
+	bitSize := len(words) * 11
+	checksumSize := bitSize / 33
+
+	v := new(big.Int)
+	for _, word := range words {
+		v.Lsh(v, 11)
+		v.Or(v, big.NewInt(int64(bip39.ReverseWordMap[word])))
+	}
+
+	// Drop the checksum bits and pad the entropy to its full length.
+	v.Rsh(v, uint(checksumSize))
+	return v.FillBytes(make([]byte, (bitSize-checksumSize)/8)), nil
+}
+
+// SplitMnemonic splits the entropy of the mnemonic into count Shamir shares, any threshold of which
+// reconstruct it. It returns the encoded shares, each carrying a checksum.
+func (c *Client) SplitMnemonic(mnemonic string, threshold, count int) ([]string, error) {
+	// Recover the entropy encoded by the mnemonic.
+	entropy, err := mnemonicEntropy(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	// Split the entropy into shares.
+	shares, err := shamir.Split(entropy, threshold, count)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(shares))
+	for _, share := range shares {
+		res = append(res, share.String())
+	}
+
+	return res, nil
+}
+
+// CombineMnemonicShares reconstructs the mnemonic from encoded Shamir shares created with SplitMnemonic.
+// A corrupted share is rejected by its checksum, shares of different splits are rejected by their split ID,
+// and the reconstructed entropy is verified against the digest stored in the shares.
+func (c *Client) CombineMnemonicShares(values []string) (string, error) {
+	// Parse each share, verifying its checksum.
+	shares := make([]*shamir.Share, 0, len(values))
+	for _, v := range values {
+		share, err := shamir.ParseShare(v)
+		if err != nil {
+			return "", err
+		}
+
+		shares = append(shares, share)
+	}
+
+	// Reconstruct the entropy from the shares.
+	entropy, err := shamir.Combine(shares)
+	if err != nil {
+		return "", err
+	}
+
+	// Encode the entropy back into a mnemonic.
+	return bip39.NewMnemonic(entropy)
+}
+
 // CreateKey creates a new key in the keyring with the provided name, mnemonic, and bip39 passphrase.
 // If mnemonic is empty, a new mnemonic is generated. It returns the mnemonic, the created key record, or an error.
 func (c *Client) CreateKey(name, mnemonic, bip39Pass string, opts *Options) (string, *keyring.Record, error) {
@@ -87,7 +196,7 @@ func (c *Client) CreateKey(name, mnemonic, bip39Pass string, opts *Options) (str
 
 	// Generate a new mnemonic if none is provided.
 	if mnemonic == "" {
-		mnemonic, err = c.NewMnemonic()
+		mnemonic, err = c.NewMnemonic(defaultMnemonicWords)
 		if err != nil {
 			return "", nil, err
 		}
@@ -101,3 +210,168 @@ func (c *Client) CreateKey(name, mnemonic, bip39Pass string, opts *Options) (str
 
 	return mnemonic, key, nil
 }
+
+// DeriveKey derives the key for the HD path of the provided key options from the mnemonic and
+// bip39 passphrase without storing it. The returned record is held in a temporary in-memory keyring.
+func (c *Client) DeriveKey(mnemonic, bip39Pass string, key *options.Key) (*keyring.Record, error) {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, errors.New("invalid mnemonic")
+	}
+
+	// Derive the key in a throwaway keyring so nothing is persisted.
+	kr := keyring.NewInMemory(c)
+	hdPath := key.HDPath()
+
+	return kr.NewAccount(hdPath, mnemonic, bip39Pass, hdPath, key.SignatureAlgo())
+}
+
+// RenameKey renames the key in the keyring from the old name to the new name.
+// It initializes a keyring based on the provided options and renames the key.
+func (c *Client) RenameKey(from, to string, opts *Options) error {
+	// Initialize a keyring based on the provided options.
+	kr, err := c.Keyring(opts)
+	if err != nil {
+		return err
+	}
+
+	// Rename the key in the keyring.
+	return kr.Rename(from, to)
+}
+
+// ExportKey exports the private key with the provided name in ASCII-armored format,
+// encrypted with the provided passphrase.
+func (c *Client) ExportKey(name, passphrase string, opts *Options) (string, error) {
+	// Initialize a keyring based on the provided options.
+	kr, err := c.Keyring(opts)
+	if err != nil {
+		return "", err
+	}
+
+	// Export the encrypted private key from the keyring.
+	return kr.ExportPrivKeyArmor(name, passphrase)
+}
+
+// ImportKey imports an ASCII-armored private key encrypted with the provided passphrase
+// into the keyring under the provided name. It returns the imported key record.
+func (c *Client) ImportKey(name, armor, passphrase string, opts *Options) (*keyring.Record, error) {
+	// Initialize a keyring based on the provided options.
+	kr, err := c.Keyring(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Import the private key into the keyring.
+	if err := kr.ImportPrivKey(name, armor, passphrase); err != nil {
+		return nil, err
+	}
+
+	return kr.Key(name)
+}
+
+// ImportKeyHex imports a hex encoded private key of the provided signing algorithm
+// into the keyring under the provided name. It returns the imported key record.
+func (c *Client) ImportKeyHex(name, privKey, algo string, opts *Options) (*keyring.Record, error) {
+	// Initialize a keyring based on the provided options.
+	kr, err := c.Keyring(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Import the private key into the keyring.
+	if err := kr.ImportPrivKeyHex(name, privKey, algo); err != nil {
+		return nil, err
+	}
+
+	return kr.Key(name)
+}
+
+// migrateKey copies a single key record from the source keyring to the destination keyring.
+// Local keys are transferred as armored private keys encrypted with a random passphrase,
+// while offline, multisig and ledger keys are recreated from their public information.
+func migrateKey(src, dst keyring.Keyring, record *keyring.Record) (*keyring.Record, error) {
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	switch record.GetType() {
+	case keyring.TypeLocal:
+		passphrase, err := randomPassphrase()
+		if err != nil {
+			return nil, err
+		}
+
+		armor, err := src.ExportPrivKeyArmor(record.Name, passphrase)
+		if err != nil {
+			return nil, err
+		}
+		if err := dst.ImportPrivKey(record.Name, armor, passphrase); err != nil {
+			return nil, err
+		}
+
+		return dst.Key(record.Name)
+	case keyring.TypeOffline:
+		return dst.SaveOfflineKey(record.Name, pubKey)
+	case keyring.TypeMulti:
+		return dst.SaveMultisig(record.Name, pubKey)
+	case keyring.TypeLedger:
+		path := record.GetLedger().GetPath()
+		hrp := cosmossdk.GetConfig().GetBech32AccountAddrPrefix()
+		return dst.SaveLedgerKey(record.Name, hd.Secp256k1, hrp, path.CoinType, path.Account, path.AddressIndex)
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", record.GetType())
+	}
+}
+
+// randomPassphrase returns a random passphrase used to encrypt keys in transit between keyrings.
+func randomPassphrase() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buf), nil
+}
+
+// MigrateKeys copies every key from the keyring of the provided options to the destination keyring,
+// for example from the test backend to the file or os backend. The source keyring is left untouched
+// so the migration can be verified before the old keys are deleted. It fails without copying any key
+// if a key with the same name already exists in the destination. Ledger keys require the device.
+func (c *Client) MigrateKeys(dst *options.Keyring, opts *Options) ([]*keyring.Record, error) {
+	// Initialize the source keyring based on the provided options.
+	srcKr, err := c.Keyring(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize the destination keyring.
+	dstKr, err := dst.Keystore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := srcKr.List()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for conflicting names before copying any key.
+	for _, record := range records {
+		if _, err := dstKr.Key(record.Name); err == nil {
+			return nil, fmt.Errorf("key with name '%s' already exists in the destination keyring", record.Name)
+		}
+	}
+
+	// Copy each key to the destination keyring.
+	res := make([]*keyring.Record, 0, len(records))
+	for _, record := range records {
+		key, err := migrateKey(srcKr, dstKr, record)
+		if err != nil {
+			return res, fmt.Errorf("failed to migrate key '%s': %w", record.Name, err)
+		}
+
+		res = append(res, key)
+	}
+
+	return res, nil
+}
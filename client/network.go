@@ -0,0 +1,198 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+	"github.com/sentinel-official/sentinel-go-sdk/options"
+	"github.com/sentinel-official/sentinel-go-sdk/utils"
+)
+
+// NetworksFileName is the name of the file holding the user-defined network profiles, without extension.
+const NetworksFileName = "networks"
+
+// Network represents a named profile of the settings needed to connect to a chain.
+type Network struct {
+	Name      string   `json:"name"`       // Name is the name of the profile.
+	ChainID   string   `json:"chain_id"`   // ChainID is the identifier of the chain.
+	RPCAddrs  []string `json:"rpc_addrs"`  // RPCAddrs lists the RPC servers of the chain; the first one is queried, and the others on failure.
+	GRPCAddr  string   `json:"grpc_addr"`  // GRPCAddr is the address of the gRPC server of the chain, for clients connecting over gRPC.
+	GasPrices string   `json:"gas_prices"` // GasPrices is the gas prices for transaction execution, such as "0.1udvpn", or an amount in Denom, such as "0.1".
+	Denom     string   `json:"denom"`      // Denom is the denomination the fees are paid in.
+}
+
+// presetNetworks holds the built-in network profiles, keyed by name.
+var presetNetworks = map[string]*Network{
+	"mainnet": {
+		Name:      "mainnet",
+		ChainID:   flags.DefaultTxChainID,
+		RPCAddrs:  []string{flags.DefaultQueryRPCAddr},
+		GRPCAddr:  "grpc.sentinel.co:9090",
+		GasPrices: "0.1udvpn",
+		Denom:     "udvpn",
+	},
+	"local": {
+		Name:      "local",
+		ChainID:   "sentinelhub-local",
+		RPCAddrs:  []string{"http://127.0.0.1:26657"},
+		GRPCAddr:  "127.0.0.1:9090",
+		GasPrices: "0.1udvpn",
+		Denom:     "udvpn",
+	},
+}
+
+// gasPrices returns the gas prices of the Network. An amount without a denom, such as "0.1",
+// is in the Denom of the Network.
+func (n *Network) gasPrices() string {
+	if _, err := sdkmath.LegacyNewDecFromStr(n.GasPrices); err == nil {
+		return n.GasPrices + n.Denom
+	}
+
+	return n.GasPrices
+}
+
+// Validate ensures the fields of the Network are valid.
+func (n *Network) Validate() error {
+	if n.Name == "" {
+		return errors.New("name must not be empty")
+	}
+	if n.ChainID == "" {
+		return errors.New("chain_id must not be empty")
+	}
+	if len(n.RPCAddrs) == 0 {
+		return errors.New("rpc_addrs must not be empty")
+	}
+	for _, addr := range n.RPCAddrs {
+		if err := options.ValidateQueryRPCAddr(addr); err != nil {
+			return err
+		}
+	}
+	if n.Denom != "" {
+		if err := cosmossdk.ValidateDenom(n.Denom); err != nil {
+			return fmt.Errorf("denom must be valid: %w", err)
+		}
+	}
+
+	if n.GasPrices != "" {
+		gasPrices, err := cosmossdk.ParseDecCoins(n.gasPrices())
+		if err != nil {
+			return errors.New("gas_prices must be valid decimal coins, or an amount in denom")
+		}
+		for _, item := range gasPrices {
+			if n.Denom != "" && item.Denom != n.Denom {
+				return fmt.Errorf("gas_prices must be in denom %s", n.Denom)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Apply populates the query and tx options with the settings of the Network. Queries are sent to the
+// first RPC server and fail over to the others, and fees are paid in the denom of the Network.
+func (n *Network) Apply(opts *Options) {
+	if opts.Query == nil {
+		opts.Query = options.NewQuery()
+	}
+	if opts.Tx == nil {
+		opts.Tx = options.NewTx()
+	}
+
+	opts.Query.RPCAddr = n.RPCAddrs[0]
+	opts.Query.RPCAddrs = append([]string(nil), n.RPCAddrs[1:]...)
+	opts.Tx.ChainID = n.ChainID
+	opts.Tx.GasPrices = n.gasPrices()
+}
+
+// LoadNetworks returns the built-in network profiles merged with the user-defined profiles of the
+// networks file in the home directory. A user-defined profile replaces a built-in one with the same name.
+func LoadNetworks(homeDir string) (map[string]*Network, error) {
+	m := make(map[string]*Network, len(presetNetworks))
+	for name, item := range presetNetworks {
+		v := *item
+		v.RPCAddrs = append([]string(nil), item.RPCAddrs...)
+		m[name] = &v
+	}
+
+	path, err := utils.FindFile(homeDir, NetworksFileName, configFileExts...)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return m, nil
+	}
+
+	// The networks file holds a table of profiles keyed by name.
+	var items map[string]*Network
+	if err := utils.DecodeFile(path, &items); err != nil {
+		return nil, err
+	}
+
+	for name, item := range items {
+		if item == nil {
+			return nil, fmt.Errorf("network %s in %s must not be empty", name, path)
+		}
+
+		item.Name = name
+		if err := item.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid network %s in %s: %w", name, path, err)
+		}
+
+		m[name] = item
+	}
+
+	return m, nil
+}
+
+// SortedNetworks returns the network profiles sorted by name.
+func SortedNetworks(m map[string]*Network) []*Network {
+	items := make([]*Network, 0, len(m))
+	for _, item := range m {
+		items = append(items, item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	return items
+}
+
+// NetworkFromCmd returns the network profile selected by the "network" flag of the command if it is set,
+// then by the SENTINEL_NETWORK environment variable. It returns nil if no network is selected.
+func NetworkFromCmd(cmd *cobra.Command, homeDir string) (*Network, error) {
+	name := flags.DefaultNetwork
+	if f := cmd.Flags().Lookup("network"); f != nil && f.Changed {
+		v, err := flags.GetNetwork(cmd)
+		if err != nil {
+			return nil, err
+		}
+
+		name = v
+	} else if v, ok := os.LookupEnv(EnvPrefix + "_NETWORK"); ok {
+		name = v
+	}
+
+	if name == "" {
+		return nil, nil
+	}
+
+	networks, err := LoadNetworks(homeDir)
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := networks[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown network %s", name)
+	}
+
+	return v, nil
+}
@@ -19,6 +19,13 @@ const (
 // It uses gRPC to send a request to the "/sentinel.node.v2.QueryService/QueryNode" endpoint.
 // The result is a pointer to v2.Node and an error if the query fails.
 func (c *Client) Node(ctx context.Context, nodeAddr base.NodeAddress, opts *Options) (res *v2.Node, err error) {
+	// Use the legacy query service if the chain does not serve the current one.
+	if version, err := c.ModuleVersion(ctx, ModuleNode, opts); err != nil {
+		return nil, err
+	} else if version == "v1" {
+		return c.nodeV1(ctx, nodeAddr, opts)
+	}
+
 	// Initialize variables for the query.
 	var (
 		resp v2.QueryNodeResponse
@@ -40,6 +47,13 @@ func (c *Client) Node(ctx context.Context, nodeAddr base.NodeAddress, opts *Opti
 // It uses gRPC to send a request to the "/sentinel.node.v2.QueryService/QueryNodes" endpoint.
 // The result is a slice of v2.Node and an error if the query fails.
 func (c *Client) Nodes(ctx context.Context, status v1base.Status, opts *Options) (res []v2.Node, err error) {
+	// Use the legacy query service if the chain does not serve the current one.
+	if version, err := c.ModuleVersion(ctx, ModuleNode, opts); err != nil {
+		return nil, err
+	} else if version == "v1" {
+		return c.nodesV1(ctx, status, opts)
+	}
+
 	// Initialize variables for the query.
 	var (
 		resp v2.QueryNodesResponse
@@ -63,6 +77,13 @@ func (c *Client) Nodes(ctx context.Context, status v1base.Status, opts *Options)
 // It uses gRPC to send a request to the "/sentinel.node.v2.QueryService/QueryNodesForPlan" endpoint.
 // The result is a slice of v2.Node and an error if the query fails.
 func (c *Client) NodesForPlan(ctx context.Context, id uint64, status v1base.Status, opts *Options) (res []v2.Node, err error) {
+	// Use the legacy query service if the chain does not serve the current one.
+	if version, err := c.ModuleVersion(ctx, ModuleNode, opts); err != nil {
+		return nil, err
+	} else if version == "v1" {
+		return c.nodesForPlanV1(ctx, id, status, opts)
+	}
+
 	// Initialize variables for the query.
 	var (
 		resp v2.QueryNodesForPlanResponse
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+
+	base "github.com/sentinel-official/hub/v12/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	v1 "github.com/sentinel-official/hub/v12/x/node/types/v1"
+	"github.com/sentinel-official/hub/v12/x/node/types/v2"
+	v1plan "github.com/sentinel-official/hub/v12/x/plan/types/v1"
+)
+
+const (
+	// gRPC methods for querying node information from chains serving the v1 query service
+	methodQueryNodeV1         = "/sentinel.node.v1.QueryService/QueryNode"
+	methodQueryNodesV1        = "/sentinel.node.v1.QueryService/QueryNodes"
+	methodQueryNodesForPlanV1 = "/sentinel.plan.v1.QueryService/QueryNodesForPlan"
+)
+
+// nodeFromV1 converts a v1.Node into a v2.Node. The single v1 price is treated as the gigabyte price.
+func nodeFromV1(v v1.Node) v2.Node {
+	return v2.Node{
+		Address:        v.Address,
+		GigabytePrices: v.Price,
+		RemoteURL:      v.RemoteURL,
+		Status:         v.Status,
+		StatusAt:       v.StatusAt,
+	}
+}
+
+// nodesFromV1 converts a slice of v1.Node into a slice of v2.Node.
+func nodesFromV1(items []v1.Node) []v2.Node {
+	res := make([]v2.Node, len(items))
+	for i := 0; i < len(items); i++ {
+		res[i] = nodeFromV1(items[i])
+	}
+
+	return res
+}
+
+// nodeV1 queries a node from the "/sentinel.node.v1.QueryService/QueryNode" endpoint.
+func (c *Client) nodeV1(ctx context.Context, nodeAddr base.NodeAddress, opts *Options) (*v2.Node, error) {
+	// Initialize variables for the query.
+	var (
+		resp v1.QueryNodeResponse
+		req  = &v1.QueryNodeRequest{
+			Address: nodeAddr.String(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryNodeV1, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	res := nodeFromV1(resp.Node)
+	return &res, nil
+}
+
+// nodesV1 queries nodes from the "/sentinel.node.v1.QueryService/QueryNodes" endpoint.
+func (c *Client) nodesV1(ctx context.Context, status v1base.Status, opts *Options) ([]v2.Node, error) {
+	// Initialize variables for the query.
+	var (
+		resp v1.QueryNodesResponse
+		req  = &v1.QueryNodesRequest{
+			Status:     status,
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryNodesV1, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	return nodesFromV1(resp.Nodes), nil
+}
+
+// nodesForPlanV1 queries the nodes of a plan from the "/sentinel.plan.v1.QueryService/QueryNodesForPlan" endpoint.
+// The v1 query service does not filter by status, so the nodes are filtered after the query.
+func (c *Client) nodesForPlanV1(ctx context.Context, id uint64, status v1base.Status, opts *Options) ([]v2.Node, error) {
+	// Initialize variables for the query.
+	var (
+		resp v1plan.QueryNodesForPlanResponse
+		req  = &v1plan.QueryNodesForPlanRequest{
+			Id:         id,
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryNodesForPlanV1, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	res := make([]v2.Node, 0, len(resp.Nodes))
+	for _, item := range resp.Nodes {
+		if status.Equal(v1base.StatusUnspecified) || item.Status.Equal(status) {
+			res = append(res, nodeFromV1(item))
+		}
+	}
+
+	return res, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/sentinel-official/sentinel-go-sdk/client/input"
 	"github.com/sentinel-official/sentinel-go-sdk/options"
 )
 
@@ -133,44 +134,51 @@ func (o *Options) Validate() error {
 	return nil
 }
 
-// NewFromCmd creates and returns an Options instance populated with values from the command's flags.
+// NewFromCmd creates and returns an Options instance for the command. The options start from their
+// defaults and are overridden, in increasing order of precedence, by the configuration file in the
+// home directory, by the selected network profile, by environment variables, and by the flags set
+// explicitly on the command.
 func NewFromCmd(cmd *cobra.Command) (*Options, error) {
-	// Retrieves Key from command flags.
-	key, err := options.NewKeyFromCmd(cmd)
+	// Resolve the home directory holding the configuration file.
+	homeDir, err := HomeDirFromCmd(cmd)
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieves Keyring from command flags.
-	keyring, err := options.NewKeyringFromCmd(cmd)
+	opts := NewDefaultOptions()
+
+	// Load the configuration file, if one exists.
+	path, err := ConfigFilePath(homeDir)
 	if err != nil {
 		return nil, err
 	}
+	if path != "" {
+		if err := opts.LoadConfigFile(path); err != nil {
+			return nil, err
+		}
+	}
 
-	// Retrieves Page from command flags.
-	page, err := options.NewPageFromCmd(cmd)
+	// Populate the query and tx options from the selected network profile, if any.
+	network, err := NetworkFromCmd(cmd, homeDir)
 	if err != nil {
 		return nil, err
 	}
+	if network != nil {
+		network.Apply(opts)
+	}
 
-	// Retrieves Query from command flags.
-	query, err := options.NewQueryFromCmd(cmd)
-	if err != nil {
+	// Override the options with environment variables, then with flags.
+	if err := opts.ApplyEnv(); err != nil {
 		return nil, err
 	}
-
-	// Retrieves Tx from command flags.
-	tx, err := options.NewTxFromCmd(cmd)
-	if err != nil {
+	if err := opts.ApplyFlags(cmd); err != nil {
 		return nil, err
 	}
 
-	// Return a new Options instance populated with the retrieved flag values.
-	return &Options{
-		Key:     key,
-		Keyring: keyring,
-		Page:    page,
-		Query:   query,
-		Tx:      tx,
-	}, nil
+	// Use the command's input or standard input as the passphrase source, prompting on the command's error output.
+	opts.Keyring.
+		WithInput(cmd.InOrStdin()).
+		WithPrompter(input.NewTerminalPrompter(cmd.InOrStdin(), cmd.ErrOrStderr()))
+
+	return opts, nil
 }
@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	base "github.com/sentinel-official/hub/v12/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	leasetypes "github.com/sentinel-official/hub/v12/x/lease/types/v1"
+	sessiontypes "github.com/sentinel-official/hub/v12/x/session/types/v3"
+	v2subscriptiontypes "github.com/sentinel-official/hub/v12/x/subscription/types/v2"
+	subscriptiontypes "github.com/sentinel-official/hub/v12/x/subscription/types/v3"
+	"golang.org/x/sync/errgroup"
+)
+
+// SessionOverview summarizes an active session and the bandwidth it has consumed.
+type SessionOverview struct {
+	ID            uint64        `json:"id"`             // ID is the identifier of the session.
+	NodeAddress   string        `json:"node_address"`   // NodeAddress is the address of the node serving the session.
+	DownloadBytes sdkmath.Int   `json:"download_bytes"` // DownloadBytes is the number of bytes downloaded.
+	UploadBytes   sdkmath.Int   `json:"upload_bytes"`   // UploadBytes is the number of bytes uploaded.
+	Duration      time.Duration `json:"duration"`       // Duration is the time the session has been active.
+	StatusAt      time.Time     `json:"status_at"`      // StatusAt is the time of the last status change.
+}
+
+// AccountOverview aggregates the state of an account at a single block height.
+type AccountOverview struct {
+	Address       string                           `json:"address"`       // Address is the account address.
+	Height        int64                            `json:"height"`        // Height is the block height all queries were performed at.
+	Balances      cosmossdk.Coins                  `json:"balances"`      // Balances are the balances of the account.
+	Subscriptions []subscriptiontypes.Subscription `json:"subscriptions"` // Subscriptions are the subscriptions owned by the account.
+	Allocations   []v2subscriptiontypes.Allocation `json:"allocations"`   // Allocations are the allocations within the subscriptions of the account.
+	Sessions      []*SessionOverview               `json:"sessions"`      // Sessions are the active sessions of the account.
+	Leases        []leasetypes.Lease               `json:"leases"`        // Leases are the leases of the account as a provider or as a node.
+}
+
+// AccountOverview queries the balances, subscriptions, allocations, active sessions and leases
+// of the given account concurrently. All queries are pinned to the same block height, which is
+// the height in the options or the latest height if none is set.
+func (c *Client) AccountOverview(ctx context.Context, accAddr cosmossdk.AccAddress, opts *Options) (*AccountOverview, error) {
+	// Pin every query to the same height so the report is consistent.
+	height := opts.GetHeight()
+	if height == 0 {
+		status, err := c.Status(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		height = status.SyncInfo.LatestBlockHeight
+	}
+
+	opts = opts.withHeight(height)
+	res := &AccountOverview{
+		Address: accAddr.String(),
+		Height:  height,
+	}
+
+	var (
+		mu       sync.Mutex
+		g, gctx  = errgroup.WithContext(ctx)
+		provAddr = base.ProvAddress(accAddr.Bytes())
+		nodeAddr = base.NodeAddress(accAddr.Bytes())
+		leaseIDs = make(map[uint64]bool)
+	)
+
+	// addLeases appends the leases not added yet, as a lease may belong to the account both as a provider and as a node.
+	addLeases := func(items []leasetypes.Lease) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, item := range items {
+			if leaseIDs[item.ID] {
+				continue
+			}
+
+			leaseIDs[item.ID] = true
+			res.Leases = append(res.Leases, item)
+		}
+	}
+
+	// Query the balances of the account.
+	g.Go(func() error {
+		items, err := QueryAll(opts, func(opts *Options) ([]cosmossdk.Coin, error) {
+			return c.Balances(gctx, accAddr, opts)
+		})
+		if err != nil {
+			return err
+		}
+
+		res.Balances = cosmossdk.NewCoins(items...)
+		return nil
+	})
+
+	// Query the subscriptions of the account, then the allocations within each of them.
+	g.Go(func() error {
+		items, err := QueryAll(opts, func(opts *Options) ([]subscriptiontypes.Subscription, error) {
+			return c.SubscriptionsForAccount(gctx, accAddr, opts)
+		})
+		if err != nil {
+			return err
+		}
+
+		res.Subscriptions = items
+		for _, item := range items {
+			id := item.ID
+			g.Go(func() error {
+				allocations, err := QueryAll(opts, func(opts *Options) ([]v2subscriptiontypes.Allocation, error) {
+					return c.SubscriptionAllocations(gctx, id, opts)
+				})
+				if err != nil {
+					return err
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				res.Allocations = append(res.Allocations, allocations...)
+				return nil
+			})
+		}
+
+		return nil
+	})
+
+	// Query the sessions of the account and keep the active ones.
+	g.Go(func() error {
+		items, err := QueryAll(opts, func(opts *Options) ([]sessiontypes.Session, error) {
+			return c.SessionsForAccount(gctx, accAddr, opts)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if !item.GetStatus().Equal(v1base.StatusActive) {
+				continue
+			}
+
+			res.Sessions = append(res.Sessions, &SessionOverview{
+				ID:            item.GetID(),
+				NodeAddress:   item.GetNodeAddress(),
+				DownloadBytes: item.GetDownloadBytes(),
+				UploadBytes:   item.GetUploadBytes(),
+				Duration:      item.GetDuration(),
+				StatusAt:      item.GetStatusAt(),
+			})
+		}
+
+		return nil
+	})
+
+	// Query the leases of the account as a provider.
+	g.Go(func() error {
+		items, err := QueryAll(opts, func(opts *Options) ([]leasetypes.Lease, error) {
+			return c.LeasesForProvider(gctx, provAddr, opts)
+		})
+		if err != nil {
+			return err
+		}
+
+		addLeases(items)
+		return nil
+	})
+
+	// Query the leases of the node operated by the account.
+	g.Go(func() error {
+		items, err := QueryAll(opts, func(opts *Options) ([]leasetypes.Lease, error) {
+			return c.LeasesForNode(gctx, nodeAddr, opts)
+		})
+		if err != nil {
+			return err
+		}
+
+		addLeases(items)
+		return nil
+	})
+
+	// Wait for all queries to complete.
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(res.Leases, func(i, j int) bool {
+		return res.Leases[i].ID < res.Leases[j].ID
+	})
+
+	return res, nil
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"github.com/sentinel-official/sentinel-go-sdk/options"
+)
+
+// allPagesLimit is the number of items requested per page while collecting all pages of a query.
+const allPagesLimit = 1000
+
+// withPage returns a shallow copy of the options with the given page options.
+func (o *Options) withPage(v *options.Page) *Options {
+	opts := *o
+	opts.Page = v
+	return &opts
+}
+
+// withHeight returns a shallow copy of the options with the query pinned at the given height.
+func (o *Options) withHeight(v int64) *Options {
+	query := *o.Query
+	query.Height = v
+
+	opts := *o
+	opts.Query = &query
+	return &opts
+}
+
+// QueryAll repeatedly calls fn with increasing page offsets and collects the items of every page.
+// It stops when a page returns fewer items than the page limit.
+func QueryAll[T any](opts *Options, fn func(*Options) ([]T, error)) ([]T, error) {
+	page := options.NewPage().WithLimit(allPagesLimit)
+	pageOpts := opts.withPage(page)
+
+	var items []T
+	for {
+		res, err := fn(pageOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, res...)
+		if uint64(len(res)) < page.GetLimit() {
+			return items, nil
+		}
+
+		page.WithOffset(page.GetOffset() + page.GetLimit())
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/bytes"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -14,12 +15,20 @@ import (
 )
 
 // ABCIQueryWithOptions performs an ABCI query with configurable options.
+// Each retry is sent to the next RPC server of the options, so queries fail over to the fallback RPC servers.
 func (c *Client) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts *Options) (*abcitypes.ResponseQuery, error) {
-	var result *coretypes.ResultABCIQuery
+	var (
+		result  *coretypes.ResultABCIQuery
+		addrs   = opts.GetRPCAddrs()
+		attempt = 0
+	)
 
 	fn := func() error {
-		// Get the RPC client from the provided options.
-		rpcClient, err := opts.Client()
+		// Get the RPC client of the server for this attempt.
+		addr := addrs[attempt%len(addrs)]
+		attempt++
+
+		rpcClient, err := opts.ClientFor(addr)
 		if err != nil {
 			return err
 		}
@@ -69,6 +78,28 @@ func (c *Client) QuerySubspace(ctx context.Context, store string, data bytes.Hex
 	return c.ABCIQueryWithOptions(ctx, path, data, opts)
 }
 
+// queryABCI performs an ABCI query and returns the value of the response.
+// A response with a non-zero code is returned as an error resolved from its codespace and code.
+func (c *Client) queryABCI(ctx context.Context, path string, data bytes.HexBytes, opts *Options) ([]byte, error) {
+	// Perform ABCI query with options.
+	reply, err := c.ABCIQueryWithOptions(ctx, path, data, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for a nil reply.
+	if reply == nil {
+		return nil, errors.New("nil reply")
+	}
+
+	// Check for an error reported by the application.
+	if !reply.IsOK() {
+		return nil, errorsmod.ABCIError(reply.Codespace, reply.Code, reply.Log)
+	}
+
+	return reply.Value, nil
+}
+
 // QueryGRPC performs a gRPC query using ABCI with configurable options.
 // It marshals the request, queries with ABCI, and unmarshals the response.
 func (c *Client) QueryGRPC(ctx context.Context, method string, req, resp codec.ProtoMarshaler, opts *Options) error {
@@ -79,18 +110,13 @@ func (c *Client) QueryGRPC(ctx context.Context, method string, req, resp codec.P
 	}
 
 	// Perform ABCI query with options.
-	reply, err := c.ABCIQueryWithOptions(ctx, method, data, opts)
+	value, err := c.queryABCI(ctx, method, data, opts)
 	if err != nil {
 		return err
 	}
 
-	// Check for a nil reply.
-	if reply == nil {
-		return errors.New("nil reply")
-	}
-
 	// Unmarshal the ABCI response value into the provided response object.
-	if err := c.Unmarshal(reply.Value, resp); err != nil {
+	if err := c.Unmarshal(value, resp); err != nil {
 		return err
 	}
 
@@ -22,6 +22,13 @@ const (
 // It uses gRPC to send a request to the "/sentinel.session.v3.QueryService/QuerySession" endpoint.
 // The result is a pointer to v3.Session and an error if the query fails.
 func (c *Client) Session(ctx context.Context, id uint64, opts *Options) (res v3.Session, err error) {
+	// Use the legacy query service if the chain does not serve the current one.
+	if version, err := c.ModuleVersion(ctx, ModuleSession, opts); err != nil {
+		return nil, err
+	} else if version == "v2" {
+		return c.sessionV2(ctx, id, opts)
+	}
+
 	// Initialize variables for the query.
 	var (
 		resp v3.QuerySessionResponse
@@ -47,6 +54,13 @@ func (c *Client) Session(ctx context.Context, id uint64, opts *Options) (res v3.
 // It uses gRPC to send a request to the "/sentinel.session.v3.QueryService/QuerySessions" endpoint.
 // The result is a slice of v3.Session and an error if the query fails.
 func (c *Client) Sessions(ctx context.Context, opts *Options) (res []v3.Session, err error) {
+	// Use the legacy query service if the chain does not serve the current one.
+	if version, err := c.ModuleVersion(ctx, ModuleSession, opts); err != nil {
+		return nil, err
+	} else if version == "v2" {
+		return c.sessionsV2(ctx, opts)
+	}
+
 	// Initialize variables for the query.
 	var (
 		resp v3.QuerySessionsResponse
@@ -76,6 +90,13 @@ func (c *Client) Sessions(ctx context.Context, opts *Options) (res []v3.Session,
 // It uses gRPC to send a request to the "/sentinel.session.v3.QueryService/QuerySessionsForAccount" endpoint.
 // The result is a slice of v3.Session and an error if the query fails.
 func (c *Client) SessionsForAccount(ctx context.Context, accAddr cosmossdk.AccAddress, opts *Options) (res []v3.Session, err error) {
+	// Use the legacy query service if the chain does not serve the current one.
+	if version, err := c.ModuleVersion(ctx, ModuleSession, opts); err != nil {
+		return nil, err
+	} else if version == "v2" {
+		return c.sessionsForAccountV2(ctx, accAddr, opts)
+	}
+
 	// Initialize variables for the query.
 	var (
 		resp v3.QuerySessionsForAccountResponse
@@ -106,6 +127,13 @@ func (c *Client) SessionsForAccount(ctx context.Context, accAddr cosmossdk.AccAd
 // It uses gRPC to send a request to the "/sentinel.session.v3.QueryService/QuerySessionsForNode" endpoint.
 // The result is a slice of v3.Session and an error if the query fails.
 func (c *Client) SessionsForNode(ctx context.Context, nodeAddr base.NodeAddress, opts *Options) (res []v3.Session, err error) {
+	// Use the legacy query service if the chain does not serve the current one.
+	if version, err := c.ModuleVersion(ctx, ModuleSession, opts); err != nil {
+		return nil, err
+	} else if version == "v2" {
+		return c.sessionsForNodeV2(ctx, nodeAddr, opts)
+	}
+
 	// Initialize variables for the query.
 	var (
 		resp v3.QuerySessionsForNodeResponse
@@ -136,6 +164,13 @@ func (c *Client) SessionsForNode(ctx context.Context, nodeAddr base.NodeAddress,
 // It uses gRPC to send a request to the "/sentinel.session.v3.QueryService/QuerySessionsForSubscription" endpoint.
 // The result is a slice of v3.Session and an error if the query fails.
 func (c *Client) SessionsForSubscription(ctx context.Context, id uint64, opts *Options) (res []v3.Session, err error) {
+	// Use the legacy query service if the chain does not serve the current one.
+	if version, err := c.ModuleVersion(ctx, ModuleSession, opts); err != nil {
+		return nil, err
+	} else if version == "v2" {
+		return c.sessionsForSubscriptionV2(ctx, id, opts)
+	}
+
 	// Initialize variables for the query.
 	var (
 		resp v3.QuerySessionsForSubscriptionResponse
@@ -166,6 +201,13 @@ func (c *Client) SessionsForSubscription(ctx context.Context, id uint64, opts *O
 // It uses gRPC to send a request to the "/sentinel.session.v3.QueryService/QuerySessionsForAllocation" endpoint.
 // The result is a slice of v3.Session and an error if the query fails.
 func (c *Client) SessionsForSubscriptionAllocation(ctx context.Context, id uint64, accAddr cosmossdk.AccAddress, opts *Options) (res []v3.Session, err error) {
+	// Use the legacy query service if the chain does not serve the current one.
+	if version, err := c.ModuleVersion(ctx, ModuleSession, opts); err != nil {
+		return nil, err
+	} else if version == "v2" {
+		return c.sessionsForSubscriptionAllocationV2(ctx, id, accAddr, opts)
+	}
+
 	// Initialize variables for the query.
 	var (
 		resp v3.QuerySessionsForAllocationResponse
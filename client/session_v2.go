@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	base "github.com/sentinel-official/hub/v12/types"
+	v2 "github.com/sentinel-official/hub/v12/x/session/types/v2"
+	"github.com/sentinel-official/hub/v12/x/session/types/v3"
+	v3subscription "github.com/sentinel-official/hub/v12/x/subscription/types/v3"
+)
+
+const (
+	// gRPC methods for querying session information from chains serving the v2 query service
+	methodQuerySessionV2                           = "/sentinel.session.v2.QueryService/QuerySession"
+	methodQuerySessionsV2                          = "/sentinel.session.v2.QueryService/QuerySessions"
+	methodQuerySessionsForAccountV2                = "/sentinel.session.v2.QueryService/QuerySessionsForAccount"
+	methodQuerySessionsForNodeV2                   = "/sentinel.session.v2.QueryService/QuerySessionsForNode"
+	methodQuerySessionsForSubscriptionV2           = "/sentinel.session.v2.QueryService/QuerySessionsForSubscription"
+	methodQuerySessionsForSubscriptionAllocationV2 = "/sentinel.session.v2.QueryService/QuerySessionsForAllocation"
+)
+
+// sessionFromV2 converts a v2.Session into a v3.Session backed by a subscription session.
+func sessionFromV2(v v2.Session) v3.Session {
+	return &v3subscription.Session{
+		ID:             v.ID,
+		AccAddress:     v.Address,
+		NodeAddress:    v.NodeAddress,
+		SubscriptionID: v.SubscriptionID,
+		DownloadBytes:  v.Bandwidth.Download,
+		UploadBytes:    v.Bandwidth.Upload,
+		Duration:       v.Duration,
+		Status:         v.Status,
+		InactiveAt:     v.InactiveAt,
+		StatusAt:       v.StatusAt,
+	}
+}
+
+// sessionsFromV2 converts a slice of v2.Session into a slice of v3.Session.
+func sessionsFromV2(items []v2.Session) []v3.Session {
+	res := make([]v3.Session, len(items))
+	for i := 0; i < len(items); i++ {
+		res[i] = sessionFromV2(items[i])
+	}
+
+	return res
+}
+
+// sessionV2 queries a session from the "/sentinel.session.v2.QueryService/QuerySession" endpoint.
+func (c *Client) sessionV2(ctx context.Context, id uint64, opts *Options) (v3.Session, error) {
+	// Initialize variables for the query.
+	var (
+		resp v2.QuerySessionResponse
+		req  = &v2.QuerySessionRequest{
+			Id: id,
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQuerySessionV2, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	return sessionFromV2(resp.Session), nil
+}
+
+// sessionsV2 queries sessions from the "/sentinel.session.v2.QueryService/QuerySessions" endpoint.
+func (c *Client) sessionsV2(ctx context.Context, opts *Options) ([]v3.Session, error) {
+	// Initialize variables for the query.
+	var (
+		resp v2.QuerySessionsResponse
+		req  = &v2.QuerySessionsRequest{
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQuerySessionsV2, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	return sessionsFromV2(resp.Sessions), nil
+}
+
+// sessionsForAccountV2 queries the sessions of an account from the
+// "/sentinel.session.v2.QueryService/QuerySessionsForAccount" endpoint.
+func (c *Client) sessionsForAccountV2(ctx context.Context, accAddr cosmossdk.AccAddress, opts *Options) ([]v3.Session, error) {
+	// Initialize variables for the query.
+	var (
+		resp v2.QuerySessionsForAccountResponse
+		req  = &v2.QuerySessionsForAccountRequest{
+			Address:    accAddr.String(),
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQuerySessionsForAccountV2, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	return sessionsFromV2(resp.Sessions), nil
+}
+
+// sessionsForNodeV2 queries the sessions of a node from the
+// "/sentinel.session.v2.QueryService/QuerySessionsForNode" endpoint.
+func (c *Client) sessionsForNodeV2(ctx context.Context, nodeAddr base.NodeAddress, opts *Options) ([]v3.Session, error) {
+	// Initialize variables for the query.
+	var (
+		resp v2.QuerySessionsForNodeResponse
+		req  = &v2.QuerySessionsForNodeRequest{
+			Address:    nodeAddr.String(),
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQuerySessionsForNodeV2, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	return sessionsFromV2(resp.Sessions), nil
+}
+
+// sessionsForSubscriptionV2 queries the sessions of a subscription from the
+// "/sentinel.session.v2.QueryService/QuerySessionsForSubscription" endpoint.
+func (c *Client) sessionsForSubscriptionV2(ctx context.Context, id uint64, opts *Options) ([]v3.Session, error) {
+	// Initialize variables for the query.
+	var (
+		resp v2.QuerySessionsForSubscriptionResponse
+		req  = &v2.QuerySessionsForSubscriptionRequest{
+			Id:         id,
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQuerySessionsForSubscriptionV2, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	return sessionsFromV2(resp.Sessions), nil
+}
+
+// sessionsForSubscriptionAllocationV2 queries the sessions of a subscription allocation from the
+// "/sentinel.session.v2.QueryService/QuerySessionsForAllocation" endpoint.
+func (c *Client) sessionsForSubscriptionAllocationV2(ctx context.Context, id uint64, accAddr cosmossdk.AccAddress, opts *Options) ([]v3.Session, error) {
+	// Initialize variables for the query.
+	var (
+		resp v2.QuerySessionsForAllocationResponse
+		req  = &v2.QuerySessionsForAllocationRequest{
+			Id:         id,
+			Address:    accAddr.String(),
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQuerySessionsForSubscriptionAllocationV2, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	return sessionsFromV2(resp.Sessions), nil
+}
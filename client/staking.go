@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	// gRPC methods for querying staking information
+	methodQueryValidator                      = "/cosmos.staking.v1beta1.Query/Validator"
+	methodQueryValidators                     = "/cosmos.staking.v1beta1.Query/Validators"
+	methodQueryDelegation                     = "/cosmos.staking.v1beta1.Query/Delegation"
+	methodQueryDelegationsForAccount          = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
+	methodQueryUnbondingDelegationsForAccount = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
+	methodQueryRedelegationsForAccount        = "/cosmos.staking.v1beta1.Query/Redelegations"
+)
+
+// Validator queries and returns information about a specific validator based on the provided validator address.
+// It uses gRPC to send a request to the "/cosmos.staking.v1beta1.Query/Validator" endpoint.
+// The result is a pointer to stakingtypes.Validator and an error if the query fails.
+func (c *Client) Validator(ctx context.Context, valAddr cosmossdk.ValAddress, opts *Options) (res *stakingtypes.Validator, err error) {
+	// Initialize variables for the query.
+	var (
+		resp stakingtypes.QueryValidatorResponse
+		req  = &stakingtypes.QueryValidatorRequest{
+			ValidatorAddr: valAddr.String(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryValidator, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return a pointer to the validator and a nil error.
+	return &resp.Validator, nil
+}
+
+// Validators queries and returns a list of validators based on the provided bond status and options.
+// An unspecified status returns validators of any status.
+// It uses gRPC to send a request to the "/cosmos.staking.v1beta1.Query/Validators" endpoint.
+// The result is a slice of stakingtypes.Validator and an error if the query fails.
+func (c *Client) Validators(ctx context.Context, status stakingtypes.BondStatus, opts *Options) (res []stakingtypes.Validator, err error) {
+	// Initialize variables for the query.
+	var (
+		resp stakingtypes.QueryValidatorsResponse
+		req  = &stakingtypes.QueryValidatorsRequest{
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// The query service expects an empty status to match all validators.
+	if status != stakingtypes.Unspecified {
+		req.Status = status.String()
+	}
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryValidators, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the list of validators and a nil error.
+	return resp.Validators, nil
+}
+
+// Delegation queries and returns the delegation of an account to a specific validator.
+// It uses gRPC to send a request to the "/cosmos.staking.v1beta1.Query/Delegation" endpoint.
+// The result is a pointer to stakingtypes.DelegationResponse and an error if the query fails.
+func (c *Client) Delegation(ctx context.Context, accAddr cosmossdk.AccAddress, valAddr cosmossdk.ValAddress, opts *Options) (res *stakingtypes.DelegationResponse, err error) {
+	// Initialize variables for the query.
+	var (
+		resp stakingtypes.QueryDelegationResponse
+		req  = &stakingtypes.QueryDelegationRequest{
+			DelegatorAddr: accAddr.String(),
+			ValidatorAddr: valAddr.String(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryDelegation, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the delegation and a nil error.
+	return resp.DelegationResponse, nil
+}
+
+// DelegationsForAccount queries and returns a list of delegations of a specific account.
+// It uses gRPC to send a request to the "/cosmos.staking.v1beta1.Query/DelegatorDelegations" endpoint.
+// The result is a slice of stakingtypes.DelegationResponse and an error if the query fails.
+func (c *Client) DelegationsForAccount(ctx context.Context, accAddr cosmossdk.AccAddress, opts *Options) (res []stakingtypes.DelegationResponse, err error) {
+	// Initialize variables for the query.
+	var (
+		resp stakingtypes.QueryDelegatorDelegationsResponse
+		req  = &stakingtypes.QueryDelegatorDelegationsRequest{
+			DelegatorAddr: accAddr.String(),
+			Pagination:    opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryDelegationsForAccount, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the list of delegations and a nil error.
+	return resp.DelegationResponses, nil
+}
+
+// UnbondingDelegationsForAccount queries and returns a list of unbonding delegations of a specific account.
+// It uses gRPC to send a request to the "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations" endpoint.
+// The result is a slice of stakingtypes.UnbondingDelegation and an error if the query fails.
+func (c *Client) UnbondingDelegationsForAccount(ctx context.Context, accAddr cosmossdk.AccAddress, opts *Options) (res []stakingtypes.UnbondingDelegation, err error) {
+	// Initialize variables for the query.
+	var (
+		resp stakingtypes.QueryDelegatorUnbondingDelegationsResponse
+		req  = &stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
+			DelegatorAddr: accAddr.String(),
+			Pagination:    opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryUnbondingDelegationsForAccount, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the list of unbonding delegations and a nil error.
+	return resp.UnbondingResponses, nil
+}
+
+// RedelegationsForAccount queries and returns a list of redelegations of a specific account.
+// It uses gRPC to send a request to the "/cosmos.staking.v1beta1.Query/Redelegations" endpoint.
+// The result is a slice of stakingtypes.RedelegationResponse and an error if the query fails.
+func (c *Client) RedelegationsForAccount(ctx context.Context, accAddr cosmossdk.AccAddress, opts *Options) (res []stakingtypes.RedelegationResponse, err error) {
+	// Initialize variables for the query.
+	var (
+		resp stakingtypes.QueryRedelegationsResponse
+		req  = &stakingtypes.QueryRedelegationsRequest{
+			DelegatorAddr: accAddr.String(),
+			Pagination:    opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQueryRedelegationsForAccount, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	// Return the list of redelegations and a nil error.
+	return resp.RedelegationResponses, nil
+}
+
+// Delegate delegates the given amount from the account of the signing key to a validator.
+// It broadcasts the transaction and returns the broadcast result and an error, if any.
+func (c *Client) Delegate(ctx context.Context, valAddr cosmossdk.ValAddress, amount cosmossdk.Coin, opts *Options) (*coretypes.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stakingtypes.NewMsgDelegate(accAddr, valAddr, amount)
+	return c.BroadcastTx(ctx, []cosmossdk.Msg{msg}, opts)
+}
+
+// Undelegate undelegates the given amount of the account of the signing key from a validator.
+// It broadcasts the transaction and returns the broadcast result and an error, if any.
+func (c *Client) Undelegate(ctx context.Context, valAddr cosmossdk.ValAddress, amount cosmossdk.Coin, opts *Options) (*coretypes.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stakingtypes.NewMsgUndelegate(accAddr, valAddr, amount)
+	return c.BroadcastTx(ctx, []cosmossdk.Msg{msg}, opts)
+}
+
+// Redelegate moves the given amount of the account of the signing key from one validator to another.
+// It broadcasts the transaction and returns the broadcast result and an error, if any.
+func (c *Client) Redelegate(ctx context.Context, srcValAddr, dstValAddr cosmossdk.ValAddress, amount cosmossdk.Coin, opts *Options) (*coretypes.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := stakingtypes.NewMsgBeginRedelegate(accAddr, srcValAddr, dstValAddr, amount)
+	return c.BroadcastTx(ctx, []cosmossdk.Msg{msg}, opts)
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+// Status retrieves the status of the RPC node, including its sync state and network.
+// It takes a context and query options as input parameters,
+// and returns the status result and an error, if any.
+func (c *Client) Status(ctx context.Context, opts *Options) (*coretypes.ResultStatus, error) {
+	// Get client for querying the blockchain
+	rpc, err := opts.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	// Perform the status query
+	return rpc.Status(ctx)
+}
+
+// Block retrieves the block at the given height.
+// It takes a context, a block height, and query options as input parameters,
+// and returns the block result and an error, if any.
+func (c *Client) Block(ctx context.Context, height int64, opts *Options) (*coretypes.ResultBlock, error) {
+	// Get client for querying the blockchain
+	rpc, err := opts.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	// Perform the blockchain query for the block
+	return rpc.Block(ctx, &height)
+}
+
+// LatestBlock retrieves the latest block known to the RPC node.
+// It takes a context and query options as input parameters,
+// and returns the block result and an error, if any.
+func (c *Client) LatestBlock(ctx context.Context, opts *Options) (*coretypes.ResultBlock, error) {
+	// Get client for querying the blockchain
+	rpc, err := opts.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	// Perform the blockchain query for the latest block
+	return rpc.Block(ctx, nil)
+}
+
+// ChainID retrieves the identifier of the network the RPC node belongs to.
+// It takes a context and query options as input parameters,
+// and returns the chain ID and an error, if any.
+func (c *Client) ChainID(ctx context.Context, opts *Options) (string, error) {
+	status, err := c.Status(ctx, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return status.NodeInfo.Network, nil
+}
+
+// checkBroadcast verifies that the RPC node is able to accept the transaction.
+// It returns an error if the node is still catching up or if it belongs to a
+// network other than the chain ID in the transaction options.
+func (c *Client) checkBroadcast(ctx context.Context, opts *Options) error {
+	status, err := c.Status(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	// Reject nodes that have not caught up with the network
+	if status.SyncInfo.CatchingUp {
+		return fmt.Errorf("rpc node is catching up at height %d", status.SyncInfo.LatestBlockHeight)
+	}
+
+	// Reject nodes of a network other than the one the transaction is signed for
+	if network := status.NodeInfo.Network; network != opts.ChainID {
+		return fmt.Errorf("chain id %s does not match rpc node network %s", opts.ChainID, network)
+	}
+
+	return nil
+}
@@ -10,10 +10,10 @@ import (
 
 const (
 	// gRPC methods for querying subscription information
-	methodQuerySubscription            = "/sentinel.subscription.v2.QueryService/QuerySubscription"
-	methodQuerySubscriptions           = "/sentinel.subscription.v2.QueryService/QuerySubscriptions"
-	methodQuerySubscriptionsForAccount = "/sentinel.subscription.v2.QueryService/QuerySubscriptionsForAccount"
-	methodQuerySubscriptionsForPlan    = "/sentinel.subscription.v2.QueryService/QuerySubscriptionsForPlan"
+	methodQuerySubscription            = "/sentinel.subscription.v3.QueryService/QuerySubscription"
+	methodQuerySubscriptions           = "/sentinel.subscription.v3.QueryService/QuerySubscriptions"
+	methodQuerySubscriptionsForAccount = "/sentinel.subscription.v3.QueryService/QuerySubscriptionsForAccount"
+	methodQuerySubscriptionsForPlan    = "/sentinel.subscription.v3.QueryService/QuerySubscriptionsForPlan"
 
 	// gRPC methods for querying subscription allocation information
 	methodQuerySubscriptionAllocation  = "/sentinel.subscription.v2.QueryService/QueryAllocation"
@@ -24,6 +24,13 @@ const (
 // It uses gRPC to send a request to the "/sentinel.subscription.v3.QueryService/QuerySubscription" endpoint.
 // The result is a v3.Subscription and an error if the query fails.
 func (c *Client) Subscription(ctx context.Context, id uint64, opts *Options) (res *v3.Subscription, err error) {
+	// Use the legacy query service if the chain does not serve the current one.
+	if version, err := c.ModuleVersion(ctx, ModuleSubscription, opts); err != nil {
+		return nil, err
+	} else if version == "v2" {
+		return c.subscriptionV2(ctx, id, opts)
+	}
+
 	// Initialize variables for the query.
 	var (
 		resp v3.QuerySubscriptionResponse
@@ -45,6 +52,13 @@ func (c *Client) Subscription(ctx context.Context, id uint64, opts *Options) (re
 // It uses gRPC to send a request to the "/sentinel.subscription.v3.QueryService/QuerySubscriptions" endpoint.
 // The result is a slice of v3.Subscription and an error if the query fails.
 func (c *Client) Subscriptions(ctx context.Context, opts *Options) (res []v3.Subscription, err error) {
+	// Use the legacy query service if the chain does not serve the current one.
+	if version, err := c.ModuleVersion(ctx, ModuleSubscription, opts); err != nil {
+		return nil, err
+	} else if version == "v2" {
+		return c.subscriptionsV2(ctx, opts)
+	}
+
 	// Initialize variables for the query.
 	var (
 		resp v3.QuerySubscriptionsResponse
@@ -67,6 +81,13 @@ func (c *Client) Subscriptions(ctx context.Context, opts *Options) (res []v3.Sub
 // The result is a slice of v3.Subscription and an error if the query fails.
 // The account is identified by the provided cosmossdk.AccAddress.
 func (c *Client) SubscriptionsForAccount(ctx context.Context, accAddr cosmossdk.AccAddress, opts *Options) (res []v3.Subscription, err error) {
+	// Use the legacy query service if the chain does not serve the current one.
+	if version, err := c.ModuleVersion(ctx, ModuleSubscription, opts); err != nil {
+		return nil, err
+	} else if version == "v2" {
+		return c.subscriptionsForAccountV2(ctx, accAddr, opts)
+	}
+
 	// Initialize variables for the query.
 	var (
 		resp v3.QuerySubscriptionsForAccountResponse
@@ -90,6 +111,13 @@ func (c *Client) SubscriptionsForAccount(ctx context.Context, accAddr cosmossdk.
 // The result is a slice of v3.Subscription and an error if the query fails.
 // The plan is identified by the provided ID.
 func (c *Client) SubscriptionsForPlan(ctx context.Context, id uint64, opts *Options) (res []v3.Subscription, err error) {
+	// Use the legacy query service if the chain does not serve the current one.
+	if version, err := c.ModuleVersion(ctx, ModuleSubscription, opts); err != nil {
+		return nil, err
+	} else if version == "v2" {
+		return c.subscriptionsForPlanV2(ctx, id, opts)
+	}
+
 	// Initialize variables for the query.
 	var (
 		resp v3.QuerySubscriptionsForPlanResponse
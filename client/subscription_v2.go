@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sentinel-official/hub/v12/x/subscription/types/v2"
+	"github.com/sentinel-official/hub/v12/x/subscription/types/v3"
+)
+
+const (
+	// gRPC methods for querying subscription information from chains serving the v2 query service
+	methodQuerySubscriptionV2            = "/sentinel.subscription.v2.QueryService/QuerySubscription"
+	methodQuerySubscriptionsV2           = "/sentinel.subscription.v2.QueryService/QuerySubscriptions"
+	methodQuerySubscriptionsForAccountV2 = "/sentinel.subscription.v2.QueryService/QuerySubscriptionsForAccount"
+	methodQuerySubscriptionsForPlanV2    = "/sentinel.subscription.v2.QueryService/QuerySubscriptionsForPlan"
+)
+
+// subscriptionFromV2 converts a v2.Subscription into a v3.Subscription.
+// A node subscription is priced by its deposit and has no plan. A plan subscription
+// carries only the denom of its price, so the amount is left at zero.
+func subscriptionFromV2(v v2.Subscription) (res v3.Subscription, err error) {
+	res = v3.Subscription{
+		ID:         v.GetID(),
+		AccAddress: v.GetAddress().String(),
+		Status:     v.GetStatus(),
+		InactiveAt: v.GetInactiveAt(),
+		StatusAt:   v.GetStatusAt(),
+	}
+
+	switch s := v.(type) {
+	case *v2.NodeSubscription:
+		res.Price = s.Deposit
+	case *v2.PlanSubscription:
+		res.PlanID = s.PlanID
+		res.Price = cosmossdk.Coin{Denom: s.Denom, Amount: sdkmath.ZeroInt()}
+	default:
+		return res, fmt.Errorf("unknown subscription type %T", v)
+	}
+
+	return res, nil
+}
+
+// subscriptionsFromV2 unpacks and converts a slice of packed v2.Subscription into a slice of v3.Subscription.
+func (c *Client) subscriptionsFromV2(items []*codectypes.Any) ([]v3.Subscription, error) {
+	res := make([]v3.Subscription, len(items))
+	for i := 0; i < len(items); i++ {
+		var item v2.Subscription
+		if err := c.UnpackAny(items[i], &item); err != nil {
+			return nil, err
+		}
+
+		v, err := subscriptionFromV2(item)
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = v
+	}
+
+	return res, nil
+}
+
+// subscriptionV2 queries a subscription from the "/sentinel.subscription.v2.QueryService/QuerySubscription" endpoint.
+func (c *Client) subscriptionV2(ctx context.Context, id uint64, opts *Options) (*v3.Subscription, error) {
+	// Initialize variables for the query.
+	var (
+		resp v2.QuerySubscriptionResponse
+		req  = &v2.QuerySubscriptionRequest{
+			Id: id,
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQuerySubscriptionV2, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	items, err := c.subscriptionsFromV2([]*codectypes.Any{resp.Subscription})
+	if err != nil {
+		return nil, err
+	}
+
+	return &items[0], nil
+}
+
+// subscriptionsV2 queries subscriptions from the "/sentinel.subscription.v2.QueryService/QuerySubscriptions" endpoint.
+func (c *Client) subscriptionsV2(ctx context.Context, opts *Options) ([]v3.Subscription, error) {
+	// Initialize variables for the query.
+	var (
+		resp v2.QuerySubscriptionsResponse
+		req  = &v2.QuerySubscriptionsRequest{
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQuerySubscriptionsV2, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	return c.subscriptionsFromV2(resp.Subscriptions)
+}
+
+// subscriptionsForAccountV2 queries the subscriptions of an account from the
+// "/sentinel.subscription.v2.QueryService/QuerySubscriptionsForAccount" endpoint.
+func (c *Client) subscriptionsForAccountV2(ctx context.Context, accAddr cosmossdk.AccAddress, opts *Options) ([]v3.Subscription, error) {
+	// Initialize variables for the query.
+	var (
+		resp v2.QuerySubscriptionsForAccountResponse
+		req  = &v2.QuerySubscriptionsForAccountRequest{
+			Address:    accAddr.String(),
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQuerySubscriptionsForAccountV2, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	return c.subscriptionsFromV2(resp.Subscriptions)
+}
+
+// subscriptionsForPlanV2 queries the subscriptions of a plan from the
+// "/sentinel.subscription.v2.QueryService/QuerySubscriptionsForPlan" endpoint.
+func (c *Client) subscriptionsForPlanV2(ctx context.Context, id uint64, opts *Options) ([]v3.Subscription, error) {
+	// Initialize variables for the query.
+	var (
+		resp v2.QuerySubscriptionsForPlanResponse
+		req  = &v2.QuerySubscriptionsForPlanRequest{
+			Id:         id,
+			Pagination: opts.PageRequest(),
+		}
+	)
+
+	// Send a gRPC query using the provided context, method, request, response, and options.
+	if err := c.QueryGRPC(ctx, methodQuerySubscriptionsForPlanV2, req, &resp, opts); err != nil {
+		return nil, err
+	}
+
+	return c.subscriptionsFromV2(resp.Subscriptions)
+}
@@ -2,7 +2,10 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -11,6 +14,7 @@ import (
 	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
 )
 
 // Simulate simulates the execution of a transaction before broadcasting it.
@@ -191,10 +195,84 @@ func (c *Client) prepareTx(ctx context.Context, key *keyring.Record, account aut
 	return txb, nil
 }
 
+// feesFromGasPrices calculates the fees required for the given gas limit at the given gas prices.
+// Each fee amount is rounded up to the nearest integer.
+func feesFromGasPrices(gasPrices sdk.DecCoins, gas uint64) sdk.Coins {
+	gasLimit := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gas))
+
+	fees := make(sdk.Coins, 0, gasPrices.Len())
+	for _, gasPrice := range gasPrices {
+		amount := gasPrice.Amount.Mul(gasLimit).Ceil().RoundInt()
+		fees = append(fees, sdk.NewCoin(gasPrice.Denom, amount))
+	}
+
+	return sdk.NewCoins(fees...)
+}
+
+// EstimateGas estimates the gas required by a transaction containing the given messages.
+// It takes a context, message(s), and transaction options as input parameters,
+// and returns the estimated gas, adjusted by the gas adjustment, and an error, if any.
+func (c *Client) EstimateGas(ctx context.Context, msgs []sdk.Msg, opts *Options) (uint64, error) {
+	// Get key for signing
+	key, err := c.Key(opts.FromName, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	// Retrieve the address from the key record
+	accAddr, err := key.GetAddress()
+	if err != nil {
+		return 0, err
+	}
+
+	// Get account information for the address
+	account, err := c.Account(ctx, accAddr, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	// Prepare the transaction, which already carries the simulated gas limit if simulation is enabled
+	txb, err := c.prepareTx(ctx, key, account, msgs, opts)
+	if err != nil {
+		return 0, err
+	}
+	if opts.SimulateAndExecute {
+		return txb.GetTx().GetGas(), nil
+	}
+
+	// Simulate the transaction to estimate the gas usage
+	return c.simulateTx(ctx, txb, opts)
+}
+
+// EstimateFees estimates the fees of a transaction containing the given messages.
+// If fees are set in the options they are returned as is, otherwise they are derived
+// from the estimated gas and the configured gas prices.
+func (c *Client) EstimateFees(ctx context.Context, msgs []sdk.Msg, opts *Options) (sdk.Coins, error) {
+	// Return the fixed fees if they are configured
+	if fees := opts.GetFees(); !fees.IsZero() {
+		return fees, nil
+	}
+
+	// Estimate the gas usage of the transaction
+	gas, err := c.EstimateGas(ctx, msgs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate the fees from the gas prices
+	return feesFromGasPrices(opts.GetGasPrices(), gas), nil
+}
+
 // BroadcastTx broadcasts a signed transaction.
+// The transaction is rejected before signing if the RPC node is catching up or belongs to another network.
 // It takes a context, message(s), and transaction options as input parameters,
 // and returns the broadcast result and an error, if any.
 func (c *Client) BroadcastTx(ctx context.Context, msgs []sdk.Msg, opts *Options) (*coretypes.ResultBroadcastTx, error) {
+	// Ensure the RPC node is synced and belongs to the expected network
+	if err := c.checkBroadcast(ctx, opts); err != nil {
+		return nil, err
+	}
+
 	// Get key for signing
 	key, err := c.Key(opts.FromName, opts)
 	if err != nil {
@@ -241,3 +319,47 @@ func (c *Client) Tx(ctx context.Context, hash []byte, opts *Options) (*coretypes
 	// Perform the blockchain query for the transaction
 	return rpc.Tx(ctx, hash, opts.Prove)
 }
+
+// WaitForTx polls for the transaction with the given hash until it is included in a block.
+// It retries as many times as the maximum retries in the options, waiting the retry delay in between.
+func (c *Client) WaitForTx(ctx context.Context, hash []byte, opts *Options) (*coretypes.ResultTx, error) {
+	for i := 0; ; i++ {
+		res, err := c.Tx(ctx, hash, opts)
+		if err == nil {
+			return res, nil
+		}
+		if i >= opts.GetMaxRetries() {
+			return nil, fmt.Errorf("transaction %X was not included in a block: %w", hash, err)
+		}
+
+		// Wait for the next block before retrying
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(opts.GetRetryDelay()):
+		}
+	}
+}
+
+// TxMsgResponses decodes the message responses from the data of a transaction result.
+// The responses are returned in the order of the messages of the transaction.
+func (c *Client) TxMsgResponses(data []byte) ([]proto.Message, error) {
+	// Decode the transaction message data.
+	var msgData sdk.TxMsgData
+	if err := c.Unmarshal(data, &msgData); err != nil {
+		return nil, err
+	}
+
+	// Unpack each message response into its concrete type.
+	res := make([]proto.Message, 0, len(msgData.MsgResponses))
+	for _, item := range msgData.MsgResponses {
+		var msg txtypes.MsgResponse
+		if err := c.UnpackAny(item, &msg); err != nil {
+			return nil, err
+		}
+
+		res = append(res, msg.(proto.Message))
+	}
+
+	return res, nil
+}
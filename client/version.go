@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Names of the hub modules whose query services are resolved per chain.
+const (
+	ModuleNode         = "node"
+	ModuleSession      = "session"
+	ModuleSubscription = "subscription"
+)
+
+// moduleVersions lists the query service versions supported for each module, newest first.
+var moduleVersions = map[string][]string{
+	ModuleNode:         {"v2", "v1"},
+	ModuleSession:      {"v3", "v2"},
+	ModuleSubscription: {"v3", "v2"},
+}
+
+// moduleProbeMethods holds the query method used to check whether a module version is served.
+// The method must exist in every supported version of the module.
+var moduleProbeMethods = map[string]string{
+	ModuleNode:         "QueryNode",
+	ModuleSession:      "QuerySession",
+	ModuleSubscription: "QuerySubscription",
+}
+
+// queryServiceMethod returns the full gRPC method name of a module query service.
+func queryServiceMethod(module, version, method string) string {
+	return fmt.Sprintf("/sentinel.%s.%s.QueryService/%s", module, version, method)
+}
+
+// isUnknownQueryPath reports whether the error indicates that the queried method is not served by the chain.
+func isUnknownQueryPath(err error) bool {
+	return errors.Is(err, sdkerrors.ErrUnknownRequest) && strings.Contains(err.Error(), "unknown query path")
+}
+
+// isABCIError reports whether the error was returned by the application rather than the transport.
+func isABCIError(err error) bool {
+	var e *errorsmod.Error
+	return errors.As(err, &e)
+}
+
+// moduleVersionKey returns the cache key of a module version for the RPC address in the options.
+func moduleVersionKey(module string, opts *Options) string {
+	return opts.GetRPCAddr() + "#" + module
+}
+
+// WithModuleVersion pins the query service version of a module, bypassing detection.
+// It returns the updated Client instance.
+func (c *Client) WithModuleVersion(module, version string) *Client {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.versions == nil {
+		c.versions = make(map[string]string)
+	}
+
+	c.versions[module] = version
+	return c
+}
+
+// ModuleVersion returns the query service version of the module served by the chain.
+// A version pinned with WithModuleVersion takes precedence. Otherwise, each supported version is
+// probed from newest to oldest and the first one served is cached for the RPC address in the options.
+func (c *Client) ModuleVersion(ctx context.Context, module string, opts *Options) (string, error) {
+	key := moduleVersionKey(module, opts)
+
+	// Return a pinned or previously detected version.
+	c.Lock()
+	if version, ok := c.versions[module]; ok {
+		c.Unlock()
+		return version, nil
+	}
+	if version, ok := c.versions[key]; ok {
+		c.Unlock()
+		return version, nil
+	}
+	c.Unlock()
+
+	versions, ok := moduleVersions[module]
+	if !ok {
+		return "", fmt.Errorf("unknown module %s", module)
+	}
+
+	// Probe each version with an empty request. Any reply other than an unknown query path,
+	// including a not found error, means the version is served.
+	for _, version := range versions {
+		method := queryServiceMethod(module, version, moduleProbeMethods[module])
+		if _, err := c.queryABCI(ctx, method, nil, opts); err != nil {
+			if isUnknownQueryPath(err) {
+				continue
+			}
+			if !isABCIError(err) {
+				return "", err
+			}
+		}
+
+		c.Lock()
+		if c.versions == nil {
+			c.versions = make(map[string]string)
+		}
+		c.versions[key] = version
+		c.Unlock()
+
+		return version, nil
+	}
+
+	return "", fmt.Errorf("no supported version of module %s is served by the chain", module)
+}
+
+// IsNotFound reports whether the error was returned by the application because the queried item does not exist.
+func IsNotFound(err error) bool {
+	if errors.Is(err, sdkerrors.ErrNotFound) || errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return true
+	}
+
+	// Query services report a missing item with the gRPC NotFound code, which reaches the client in the log.
+	return isABCIError(err) && strings.Contains(err.Error(), "code = NotFound")
+}
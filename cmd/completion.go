@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v2"
+	plantypes "github.com/sentinel-official/hub/v12/x/plan/types/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+)
+
+// Constants for the completion cache.
+const (
+	completionCacheDir     = "cache"          // completionCacheDir is the directory in the home directory holding the cached completions.
+	completionCacheTTL     = 10 * time.Minute // completionCacheTTL is the time a cached completion remains valid.
+	completionQueryTimeout = 5 * time.Second  // completionQueryTimeout bounds the queries made to refresh a cached completion.
+)
+
+// CompletionCmd returns a command generating the shell completion script for bash, zsh or fish.
+func CompletionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "completion [bash|zsh|fish]",
+		Short: "Generate the shell completion script",
+		Long: `Generate the shell completion script for bash, zsh or fish.
+
+To load completions in the current shell:
+
+  bash: source <(sentinel completion bash)
+  zsh:  source <(sentinel completion zsh)
+  fish: sentinel completion fish | source`,
+		Args:                  cobra.ExactArgs(1),
+		ValidArgs:             []string{"bash", "zsh", "fish"},
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			w := cmd.OutOrStdout()
+			root := cmd.Root()
+
+			switch args[0] {
+			case "bash":
+				return root.GenBashCompletionV2(w, true)
+			case "zsh":
+				return root.GenZshCompletion(w)
+			case "fish":
+				return root.GenFishCompletion(w, true)
+			default:
+				return fmt.Errorf("unsupported shell %s", args[0])
+			}
+		},
+	}
+
+	return cmd
+}
+
+// completionFunc is the signature of the functions completing arguments and flag values.
+type completionFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// completeArgs returns a completion function that completes each positional argument with the function
+// at the same index. Arguments without a function are not completed.
+func completeArgs(fns ...completionFunc) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= len(fns) || fns[len(args)] == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return fns[len(args)](cmd, args, toComplete)
+	}
+}
+
+// registerFlagCompletionFromName registers the completion of key names for the tx.from-name flag of the command.
+func registerFlagCompletionFromName(cmd *cobra.Command) {
+	_ = cmd.RegisterFlagCompletionFunc("tx.from-name", completeKeyNames)
+}
+
+// completeKeyNames completes the names of the keys in the keyring. Keyrings encrypted with a passphrase
+// are not completed, as listing them would prompt for the passphrase.
+func completeKeyNames(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	opts, err := client.NewFromCmd(cmd)
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	if opts.Keyring.Backend == keyring.BackendFile {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	// Initialize the Client
+	c := client.NewDefault()
+
+	keys, err := c.Keys(opts)
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		names = append(names, key.Name)
+	}
+
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeNetworkNames completes the names of the network profiles.
+func completeNetworkNames(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	homeDir, err := client.HomeDirFromCmd(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	networks, err := client.LoadNetworks(homeDir)
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := make([]string, 0, len(networks))
+	for _, item := range client.SortedNetworks(networks) {
+		names = append(names, item.Name)
+	}
+
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeNodeAddrs completes the addresses of all the active nodes, from a cache refreshed by querying the chain.
+func completeNodeAddrs(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	values := cachedCompletion(cmd, "nodes", func(ctx context.Context, c *client.Client, opts *client.Options) ([]string, error) {
+		nodes, err := client.QueryAll(opts, func(opts *client.Options) ([]nodetypes.Node, error) {
+			return c.Nodes(ctx, v1base.StatusActive, opts)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		values := make([]string, 0, len(nodes))
+		for _, item := range nodes {
+			values = append(values, item.Address)
+		}
+
+		return values, nil
+	})
+
+	return values, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completePlanIDs completes the IDs of all the active plans, from a cache refreshed by querying the chain.
+func completePlanIDs(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	values := cachedCompletion(cmd, "plans", func(ctx context.Context, c *client.Client, opts *client.Options) ([]string, error) {
+		plans, err := client.QueryAll(opts, func(opts *client.Options) ([]plantypes.Plan, error) {
+			return c.Plans(ctx, v1base.StatusActive, opts)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		values := make([]string, 0, len(plans))
+		for _, item := range plans {
+			values = append(values, strconv.FormatUint(item.ID, 10))
+		}
+
+		return values, nil
+	})
+
+	return values, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completionCache is a completion result cached in the home directory.
+type completionCache struct {
+	RPCAddr   string    `json:"rpc_addr"`   // RPCAddr is the address of the RPC server the values were queried from.
+	UpdatedAt time.Time `json:"updated_at"` // UpdatedAt is the time the values were queried.
+	Values    []string  `json:"values"`     // Values are the completion values.
+}
+
+// hasQueryConfig reports whether the chain to query is configured for the command, by a configuration file,
+// a network profile, an environment variable or a flag. Without one, no query is made to complete arguments.
+func hasQueryConfig(cmd *cobra.Command, homeDir string) bool {
+	if path, err := client.ConfigFilePath(homeDir); err == nil && path != "" {
+		return true
+	}
+	for _, name := range []string{"network", "query.rpc-addr"} {
+		if f := cmd.Flags().Lookup(name); f != nil && f.Changed {
+			return true
+		}
+	}
+	for _, key := range []string{"network", "query.rpc_addr"} {
+		if _, ok := os.LookupEnv(client.ConfigEnvName(key)); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cachedCompletion returns the named completion values cached in the home directory. The values are queried
+// again with the query function when the cache is missing, expired or was filled from another RPC server.
+func cachedCompletion(
+	cmd *cobra.Command, name string,
+	query func(context.Context, *client.Client, *client.Options) ([]string, error),
+) []string {
+	homeDir, err := client.HomeDirFromCmd(cmd)
+	if err != nil || !hasQueryConfig(cmd, homeDir) {
+		return nil
+	}
+
+	opts, err := client.NewFromCmd(cmd)
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil
+	}
+
+	path := filepath.Join(homeDir, completionCacheDir, "completion_"+name+".json")
+
+	// Use the cached values if they are recent and from the same RPC server.
+	var cache completionCache
+	if buf, err := os.ReadFile(path); err == nil {
+		if err := json.Unmarshal(buf, &cache); err != nil || cache.RPCAddr != opts.RPCAddr {
+			cache = completionCache{}
+		}
+		if time.Since(cache.UpdatedAt) < completionCacheTTL {
+			return cache.Values
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		cobra.CompDebugln(err.Error(), false)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionQueryTimeout)
+	defer cancel()
+
+	// Initialize the Client
+	c := client.NewDefault()
+
+	// Fall back to the expired values if the query fails.
+	values, err := query(ctx, c, opts)
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return cache.Values
+	}
+
+	cache = completionCache{
+		RPCAddr:   opts.RPCAddr,
+		UpdatedAt: time.Now(),
+		Values:    values,
+	}
+
+	if err := writeCompletionCache(path, &cache); err != nil {
+		cobra.CompDebugln(err.Error(), false)
+	}
+
+	return values
+}
+
+// writeCompletionCache writes the completion cache to the file at the path.
+func writeCompletionCache(path string, cache *completionCache) error {
+	buf, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf, 0600)
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// ConfigCmd returns a new Cobra command for configuration sub-commands.
+func ConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Sub-commands for managing the configuration file.",
+		Long: `Sub-commands for managing the configuration file. The configuration is read from config.toml,
+config.yaml, config.yml or config.json in the home directory. Each option can be overridden by an
+environment variable such as SENTINEL_QUERY_RPC_ADDR, which in turn is overridden by a flag such as --query.rpc-addr.`,
+	}
+
+	cmd.AddCommand(
+		configGet(),
+		configSet(),
+		configShow(),
+	)
+
+	flags.SetPersistentFlagHomeDir(cmd)
+	flags.SetPersistentFlagNetwork(cmd)
+
+	return cmd
+}
+
+// addConfigCmdFlags adds the flags of every configurable option to the command.
+func addConfigCmdFlags(cmd *cobra.Command) {
+	flags.AddKeyFlags(cmd)
+	flags.AddKeyringFlags(cmd)
+	flags.AddPageFlags(cmd)
+	flags.AddQueryFlags(cmd)
+	flags.AddTxFlags(cmd)
+	registerFlagCompletionFromName(cmd)
+}
+
+// configGet displays the effective value of a configuration option.
+func configGet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [key]",
+		Short: "Show the effective value of the configuration option with the specified key, such as query.rpc_addr",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			v, err := opts.ConfigValue(args[0])
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(v)
+			return nil
+		},
+	}
+
+	addConfigCmdFlags(cmd)
+
+	return cmd
+}
+
+// configSet sets the value of a configuration option in the configuration file.
+func configSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set [key] [value]",
+		Short: "Set the configuration option with the specified key in the configuration file",
+		Long: `Set the configuration option with the specified key in the configuration file.
+If no configuration file exists, config.toml is created in the home directory with the default options.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			homeDir, err := client.HomeDirFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Load the existing configuration file on top of the defaults
+			opts := client.NewDefaultOptions()
+
+			path, err := client.ConfigFilePath(homeDir)
+			if err != nil {
+				return err
+			}
+			if path == "" {
+				path = filepath.Join(homeDir, client.ConfigFileName+".toml")
+			} else if err := opts.LoadConfigFile(path); err != nil {
+				return err
+			}
+
+			// Update the option and write the configuration file back
+			if err := opts.SetConfigValue(args[0], args[1]); err != nil {
+				return err
+			}
+			if err := opts.WriteConfigFile(path); err != nil {
+				return err
+			}
+
+			cmd.Printf("Updated %s in %s.\n", args[0], path)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// configShow displays the effective configuration.
+func configShow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show the effective configuration, after applying the configuration file, environment variables and flags",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			m, err := opts.ConfigMap()
+			if err != nil {
+				return err
+			}
+
+			// Output the effective configuration
+			return writeOutputToCmd(cmd, m, outputFormat)
+		},
+	}
+
+	addConfigCmdFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
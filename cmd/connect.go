@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	base "github.com/sentinel-official/hub/v12/types"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/connector"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+	"github.com/sentinel-official/sentinel-go-sdk/pricing"
+)
+
+// newConnectorFromCmd creates a Connector backed by the hub and the node API, using the options of the command.
+func newConnectorFromCmd(cmd *cobra.Command) (*connector.Connector, error) {
+	opts, err := client.NewFromCmd(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	homeDir, err := client.HomeDirFromCmd(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize the Client
+	c := client.NewDefault()
+
+	return connector.NewConnector(
+		homeDir,
+		connector.NewHubChain(c, opts),
+		connector.NewHTTPNode(c, opts),
+		connector.NewServiceFunc(homeDir, connector.DefaultInterfaceName),
+	), nil
+}
+
+// ConnectCmd returns a new Cobra command to connect to a node.
+func ConnectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "connect [node-addr] [denom]",
+		Short: "Connect to a node, paying for gigabytes or hours in the specified denom",
+		Long: `Connect to a node, paying for gigabytes or hours in the specified denom. A session is started on the node,
+the client is added as a peer of the session by the node, and the client service is brought up.
+If any step fails, the steps already completed are rolled back. For V2Ray nodes, the client serves a local SOCKS proxy
+on 127.0.0.1:1080.`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeArgs(completeNodeAddrs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			nodeAddr, err := base.NodeAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			gigabytes, err := flags.GetHubGigabytes(cmd)
+			if err != nil {
+				return err
+			}
+
+			hours, err := flags.GetHubHours(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := pricing.ValidateQuantity(gigabytes, hours); err != nil {
+				return err
+			}
+
+			c, err := newConnectorFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Connect to the node
+			res, err := c.Connect(cmd.Context(), nodeAddr, gigabytes, hours, args[1])
+			if err != nil {
+				return err
+			}
+
+			// Output the connection
+			return writeOutputToCmd(cmd, res, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+	flags.SetFlagHubGigabytes(cmd)
+	flags.SetFlagHubHours(cmd)
+	flags.SetPersistentFlagHomeDir(cmd)
+	flags.SetPersistentFlagNetwork(cmd)
+
+	return cmd
+}
+
+// DisconnectCmd returns a new Cobra command to disconnect from the connected node.
+func DisconnectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disconnect",
+		Short: "Disconnect from the connected node, bringing the client service down and ending the session",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			c, err := newConnectorFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Disconnect from the node
+			res, err := c.Disconnect(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			// Output the ended connection
+			return writeOutputToCmd(cmd, res, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+	flags.SetPersistentFlagHomeDir(cmd)
+	flags.SetPersistentFlagNetwork(cmd)
+
+	return cmd
+}
+
+// StatusCmd returns a new Cobra command to show the status of the connection.
+func StatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the connected node, whether the client service is up, and its traffic statistics",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			c, err := newConnectorFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Retrieve the status of the connection
+			res, err := c.Status(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			// Output the status
+			return writeOutputToCmd(cmd, res, outputFormat)
+		},
+	}
+
+	flags.AddOutputFlags(cmd)
+	flags.SetPersistentFlagHomeDir(cmd)
+
+	return cmd
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+	"github.com/sentinel-official/sentinel-go-sdk/utils"
+)
+
+// addrOutput holds an address encoded in hex and with every Bech32 prefix.
+type addrOutput struct {
+	Hex  string `json:"hex" yaml:"hex"`
+	Acc  string `json:"acc" yaml:"acc"`
+	Node string `json:"node" yaml:"node"`
+	Prov string `json:"prov" yaml:"prov"`
+	Val  string `json:"val" yaml:"val"`
+}
+
+// DebugCmd returns a new Cobra command for debugging sub-commands.
+func DebugCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Sub-commands for debugging.",
+	}
+
+	cmd.AddCommand(
+		debugAddr(),
+	)
+
+	return cmd
+}
+
+// debugAddr converts an address between hex and every Bech32 prefix.
+func debugAddr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "addr [address]",
+		Short: "Convert an address given in hex or with any Bech32 prefix to every other form",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Decode the address into raw bytes
+			buf, err := utils.AddrBytesFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			output := &addrOutput{
+				Hex: strings.ToUpper(hex.EncodeToString(buf)),
+			}
+
+			// Encode the address with every Bech32 prefix
+			for kind, v := range map[string]*string{
+				utils.AddrKindAcc:  &output.Acc,
+				utils.AddrKindNode: &output.Node,
+				utils.AddrKindProv: &output.Prov,
+				utils.AddrKindVal:  &output.Val,
+			} {
+				if *v, err = utils.Bech32FromAddrBytes(kind, buf); err != nil {
+					return err
+				}
+			}
+
+			// Output the converted addresses
+			return writeOutputToCmd(cmd, output, outputFormat)
+		},
+	}
+
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
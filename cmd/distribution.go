@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// DistributionCmd returns a new Cobra command for distribution sub-commands.
+func DistributionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution",
+		Short: "Sub-commands for querying and withdrawing staking rewards.",
+	}
+
+	cmd.AddCommand(
+		distributionRewards(),
+		distributionCommission(),
+		distributionWithdrawRewards(),
+	)
+
+	flags.SetPersistentFlagHomeDir(cmd)
+	flags.SetPersistentFlagNetwork(cmd)
+
+	return cmd
+}
+
+// distributionRewards displays the rewards of an account, optionally from a single validator.
+func distributionRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards [acc-addr] [validator-addr]",
+		Short: "Show the rewards of an account, optionally from a single validator",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			accAddr, err := cosmossdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the rewards from every validator if none is specified
+			if len(args) == 1 {
+				rewards, err := c.DelegationTotalRewards(cmd.Context(), accAddr, opts)
+				if err != nil {
+					return err
+				}
+
+				return writeOutputToCmd(cmd, rewards, outputFormat)
+			}
+
+			valAddr, err := cosmossdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Fetch the rewards from the validator
+			rewards, err := c.DelegationRewards(cmd.Context(), accAddr, valAddr, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the rewards
+			return writeOutputToCmd(cmd, rewards, outputFormat)
+		},
+	}
+
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// distributionCommission displays the accumulated commission of a validator.
+func distributionCommission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commission [validator-addr]",
+		Short: "Show the accumulated commission of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := cosmossdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the commission
+			commission, err := c.ValidatorCommission(cmd.Context(), valAddr, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the commission
+			return writeOutputToCmd(cmd, commission, outputFormat)
+		},
+	}
+
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// distributionWithdrawRewards withdraws the rewards of the signing key from the given validators,
+// or from every validator with outstanding rewards if none are given.
+func distributionWithdrawRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-rewards [validator-addr]...",
+		Short: "Withdraw the rewards of the signing key from the given validators, or from all of them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddrs := make([]cosmossdk.ValAddress, 0, len(args))
+			for _, arg := range args {
+				valAddr, err := cosmossdk.ValAddressFromBech32(arg)
+				if err != nil {
+					return err
+				}
+
+				valAddrs = append(valAddrs, valAddr)
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Broadcast the withdrawal
+			res, err := c.WithdrawRewards(cmd.Context(), valAddrs, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the broadcast result
+			return writeOutputToCmd(cmd, res, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,339 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/denoms"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// GovCmd returns a new Cobra command for governance sub-commands.
+func GovCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov",
+		Short: "Sub-commands for querying proposals and voting.",
+	}
+
+	cmd.AddCommand(
+		govProposal(),
+		govProposals(),
+		govTally(),
+		govVote(),
+		govVoteWeighted(),
+		govDeposit(),
+	)
+
+	flags.SetPersistentFlagHomeDir(cmd)
+	flags.SetPersistentFlagNetwork(cmd)
+
+	return cmd
+}
+
+// proposalStatusFromString parses a proposal status such as "voting_period" into a govv1.ProposalStatus.
+// An empty string returns the unspecified status.
+func proposalStatusFromString(v string) (govv1.ProposalStatus, error) {
+	if v == "" {
+		return govv1.StatusNil, nil
+	}
+
+	status, ok := govv1.ProposalStatus_value["PROPOSAL_STATUS_"+strings.ToUpper(v)]
+	if !ok {
+		return govv1.StatusNil, fmt.Errorf("invalid proposal status %s", v)
+	}
+
+	return govv1.ProposalStatus(status), nil
+}
+
+// govProposal displays the details of the proposal with the specified ID.
+func govProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal [id]",
+		Short: "Show details of the proposal with the specified ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the proposal details
+			proposal, err := c.Proposal(cmd.Context(), id, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the proposal details
+			return writeOutputToCmd(cmd, proposal, outputFormat)
+		},
+	}
+
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// govProposals lists the proposals, by default those open for voting.
+func govProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals",
+		Short: "List proposals, by default those in the voting period",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := flags.GetGovStatus(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := proposalStatusFromString(statusStr)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of proposals
+			proposals, err := c.Proposals(cmd.Context(), status, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the proposal list
+			return writeOutputToCmd(cmd, proposals, outputFormat)
+		},
+	}
+
+	flags.AddPageFlags(cmd)
+	flags.AddQueryFlags(cmd)
+	flags.SetFlagGovStatus(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// govTally displays the tally result of the proposal with the specified ID.
+func govTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally [id]",
+		Short: "Show the tally result of the proposal with the specified ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the tally result
+			tally, err := c.ProposalTally(cmd.Context(), id, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the tally result
+			return writeOutputToCmd(cmd, tally, outputFormat)
+		},
+	}
+
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// govVote casts a vote on a proposal from the signing key.
+func govVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [id] [option]",
+		Short: "Vote on a proposal with yes, no, no_with_veto or abstain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			option, err := govv1.VoteOptionFromString(normalizeVoteOption(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Broadcast the vote
+			res, err := c.Vote(cmd.Context(), id, option, "", opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the broadcast result
+			return writeOutputToCmd(cmd, res, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
+
+// govVoteWeighted casts a weighted vote on a proposal from the signing key.
+func govVoteWeighted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-weighted [id] [options]",
+		Short: "Vote on a proposal with weighted options, such as yes=0.6,no=0.4",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Normalize the option of each weighted pair before parsing
+			pairs := strings.Split(args[1], ",")
+			for i, pair := range pairs {
+				option, weight, _ := strings.Cut(pair, "=")
+				pairs[i] = normalizeVoteOption(option) + "=" + weight
+			}
+
+			options, err := govv1.WeightedVoteOptionsFromString(strings.Join(pairs, ","))
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Broadcast the weighted vote
+			res, err := c.VoteWeighted(cmd.Context(), id, options, "", opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the broadcast result
+			return writeOutputToCmd(cmd, res, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
+
+// govDeposit deposits an amount on a proposal from the signing key.
+func govDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit [id] [amount]",
+		Short: "Deposit an amount on a proposal from the signing key",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := denoms.DefaultRegistry.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Broadcast the deposit
+			res, err := c.Deposit(cmd.Context(), id, amount, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the broadcast result
+			return writeOutputToCmd(cmd, res, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
+
+// normalizeVoteOption converts a short vote option such as "yes" into the name of a govv1.VoteOption.
+// Names that already carry the prefix are returned in upper case.
+func normalizeVoteOption(v string) string {
+	v = strings.ToUpper(strings.TrimSpace(v))
+	if strings.HasPrefix(v, "VOTE_OPTION_") {
+		return v
+	}
+
+	return "VOTE_OPTION_" + v
+}
@@ -1,10 +1,15 @@
 package cmd
 
 import (
-	"bufio"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/go-bip39"
 	"github.com/spf13/cobra"
@@ -12,6 +17,9 @@ import (
 	"github.com/sentinel-official/sentinel-go-sdk/client"
 	"github.com/sentinel-official/sentinel-go-sdk/client/input"
 	"github.com/sentinel-official/sentinel-go-sdk/flags"
+	"github.com/sentinel-official/sentinel-go-sdk/libs/shamir"
+	"github.com/sentinel-official/sentinel-go-sdk/options"
+	"github.com/sentinel-official/sentinel-go-sdk/utils"
 )
 
 // KeysCmd returns a new Cobra command for key management sub-commands.
@@ -24,85 +32,309 @@ func KeysCmd() *cobra.Command {
 	cmd.AddCommand(
 		keysAdd(),
 		keysDelete(),
+		keysDerive(),
+		keysExport(),
+		keysImport(),
+		keysImportHex(),
 		keysList(),
+		keysMigrate(),
+		keysRecoverShares(),
+		keysRename(),
 		keysShow(),
+		keysSign(),
+		keysVerify(),
 	)
 
+	flags.SetPersistentFlagHomeDir(cmd)
+
 	return cmd
 }
 
-// keysAdd creates a new key with the specified name, mnemonic, and bip39 passphrase.
+// readMnemonicFromCmd reads the bip39 mnemonic from the file or environment variable named by the
+// command flags, or prompts for it when neither is set. It reports whether the mnemonic was prompted.
+func readMnemonicFromCmd(cmd *cobra.Command, prompter input.Prompter) (string, bool, error) {
+	file, err := flags.GetKeysMnemonicFile(cmd)
+	if err != nil {
+		return "", false, err
+	}
+
+	env, err := flags.GetKeysMnemonicEnv(cmd)
+	if err != nil {
+		return "", false, err
+	}
+
+	var mnemonic string
+	prompted := false
+
+	switch {
+	case file != "" && env != "":
+		return "", false, errors.New("only one of mnemonic file or mnemonic env must be set")
+	case file != "":
+		buf, err := os.ReadFile(file)
+		if err != nil {
+			return "", false, err
+		}
+
+		mnemonic = string(buf)
+	case env != "":
+		v, ok := os.LookupEnv(env)
+		if !ok {
+			return "", false, fmt.Errorf("environment variable %s is not set", env)
+		}
+
+		mnemonic = v
+	default:
+		v, err := prompter.GetString("Enter your bip39 mnemonic.\n")
+		if err != nil {
+			return "", false, err
+		}
+
+		mnemonic, prompted = v, true
+	}
+
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return "", false, errors.New("invalid mnemonic")
+	}
+
+	return mnemonic, prompted, nil
+}
+
+// readPrivKeyHexFromCmd reads the hex encoded private key from the file or environment variable named by
+// the command flags, or prompts for it without echoing when neither is set.
+func readPrivKeyHexFromCmd(cmd *cobra.Command, prompter input.Prompter) (string, error) {
+	file, err := flags.GetKeysPrivKeyFile(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	env, err := flags.GetKeysPrivKeyEnv(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	var privKey string
+	switch {
+	case file != "" && env != "":
+		return "", errors.New("only one of private key file or private key env must be set")
+	case file != "":
+		buf, err := os.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+
+		privKey = string(buf)
+	case env != "":
+		v, ok := os.LookupEnv(env)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", env)
+		}
+
+		privKey = v
+	default:
+		v, err := prompter.GetPassword("Enter the hex encoded private key:")
+		if err != nil {
+			return "", err
+		}
+
+		privKey = v
+	}
+
+	privKey = strings.TrimSpace(privKey)
+	if privKey == "" {
+		return "", errors.New("private key must be non-empty")
+	}
+
+	return privKey, nil
+}
+
+// readBIP39PassFromCmd reads the bip39 passphrase from the environment variable named by the command flags.
+// When the variable is not named, it prompts for the passphrase if prompt is true and uses the default otherwise.
+func readBIP39PassFromCmd(cmd *cobra.Command, prompter input.Prompter, prompt bool) (string, error) {
+	env, err := flags.GetKeysBIP39PassEnv(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	if env != "" {
+		v, ok := os.LookupEnv(env)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", env)
+		}
+
+		return v, nil
+	}
+	if !prompt {
+		return "", nil
+	}
+
+	// Prompt for bip39 passphrase, using the default if the input has ended, as when only a mnemonic is piped
+	bip39Pass, err := prompter.GetPassword("Enter your bip39 passphrase, or hit enter to use the default:")
+	if errors.Is(err, io.EOF) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// Confirm passphrase if provided
+	if bip39Pass != "" {
+		confirmPass, err := prompter.GetPassword("Confirm bip39 passphrase:")
+		if err != nil {
+			return "", err
+		}
+
+		if bip39Pass != confirmPass {
+			return "", errors.New("bip39 passphrase does not match")
+		}
+	}
+
+	return bip39Pass, nil
+}
+
+// keysAdd creates a new key with the specified name from a generated or recovered mnemonic.
 func keysAdd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add [name]",
-		Short: "Add a new key with the specified name and optional mnemonic",
-		Args:  cobra.ExactArgs(1),
+		Short: "Add a new key with the specified name from a generated or recovered mnemonic",
+		Long: `Add a new key with the specified name. By default a new mnemonic is generated and printed.
+With --recover, the mnemonic is read from --mnemonic-file or --mnemonic-env, or prompted for when neither is set.
+Without --recover, a mnemonic is read from the input, prompted for on a terminal or piped, and a new one is
+generated if none is entered or the input is empty.
+The bip39 passphrase is read from --bip39-passphrase-env, or prompted for only when the mnemonic is prompted.
+The default passphrase is used when the input ends before it.
+With --shares and --share-threshold, a generated mnemonic is split into Shamir shares which are shown instead.
+With --dry-run, the key is derived and shown without being stored.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts := client.NewOptions()
-			if _, err := opts.WithKeyFromCmd(cmd); err != nil {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
 				return err
 			}
-			if _, err := opts.WithKeyringFromCmd(cmd); err != nil {
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
 				return err
 			}
 
-			outputFormat, err := flags.GetOutputFormat(cmd)
+			recoverKey, err := flags.GetKeysRecover(cmd)
 			if err != nil {
 				return err
 			}
 
-			reader := bufio.NewReader(opts.Input)
+			dryRun, err := flags.GetKeysDryRun(cmd)
+			if err != nil {
+				return err
+			}
 
-			// Prompt for mnemonic
-			mnemonic, err := input.GetString("Enter your bip39 mnemonic, or hit enter to generate one.\n", reader)
+			words, err := flags.GetKeysMnemonicWordCount(cmd)
 			if err != nil {
 				return err
 			}
 
-			if mnemonic != "" && !bip39.IsMnemonicValid(mnemonic) {
-				return fmt.Errorf("invalid mnemonic")
+			shareCount, err := flags.GetKeysShareCount(cmd)
+			if err != nil {
+				return err
 			}
 
-			// Prompt for bip39 passphrase
-			bip39Pass, err := input.GetPassword("Enter your bip39 passphrase, or hit enter to use the default:", reader)
+			shareThreshold, err := flags.GetKeysShareThreshold(cmd)
 			if err != nil {
 				return err
 			}
+			if shareCount > 0 && recoverKey {
+				return errors.New("shares can only be created for a generated mnemonic")
+			}
+
+			prompter := opts.GetPrompter()
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Read the mnemonic to recover from, or generate a new one
+			var (
+				mnemonic  string
+				prompted  bool
+				recovered = recoverKey
+			)
 
-			// Confirm passphrase if provided
-			if bip39Pass != "" {
-				confirmPass, err := input.GetPassword("Confirm bip39 passphrase:", reader)
+			switch {
+			case recoverKey:
+				mnemonic, prompted, err = readMnemonicFromCmd(cmd, prompter)
 				if err != nil {
 					return err
 				}
+			case cmd.Flags().Changed("mnemonic-file") || cmd.Flags().Changed("mnemonic-env"):
+				return errors.New("mnemonic file and mnemonic env require --recover")
+			default:
+				// Read a mnemonic to recover from, prompted for on a terminal or piped to the input. A new
+				// mnemonic is generated if none is entered, or if the input is empty.
+				v, err := prompter.GetString("Enter your bip39 mnemonic, or hit enter to generate one.\n")
+				if err != nil && !errors.Is(err, io.EOF) {
+					return err
+				}
 
-				if bip39Pass != confirmPass {
-					return errors.New("bip39 passphrase does not match")
+				mnemonic, prompted = strings.Join(strings.Fields(v), " "), err == nil
+				if mnemonic != "" {
+					if !bip39.IsMnemonicValid(mnemonic) {
+						return errors.New("invalid mnemonic")
+					}
+					if shareCount > 0 {
+						return errors.New("shares can only be created for a generated mnemonic")
+					}
+
+					recovered = true
 				}
 			}
 
-			// Initialize the Client
-			c := client.NewDefault()
-
-			// Check if the key already exists
-			if _, err := c.Key(args[0], opts); err == nil {
-				return fmt.Errorf("key with name '%s' already exists", args[0])
+			if mnemonic == "" {
+				mnemonic, err = c.NewMnemonic(words)
+				if err != nil {
+					return err
+				}
 			}
 
-			// Create the key
-			newMnemonic, key, err := c.CreateKey(args[0], mnemonic, bip39Pass, opts)
+			bip39Pass, err := readBIP39PassFromCmd(cmd, prompter, prompted)
 			if err != nil {
 				return err
 			}
 
+			var key *keyring.Record
+			if dryRun {
+				// Derive the key without storing it
+				key, err = c.DeriveKey(mnemonic, bip39Pass, opts.Key)
+				if err != nil {
+					return err
+				}
+			} else {
+				// Check if the key already exists
+				if _, err := c.Key(args[0], opts); err == nil {
+					return fmt.Errorf("key with name '%s' already exists", args[0])
+				}
+
+				// Create the key
+				_, key, err = c.CreateKey(args[0], mnemonic, bip39Pass, opts)
+				if err != nil {
+					return err
+				}
+			}
+
 			output, err := keyring.MkAccKeyOutput(key)
 			if err != nil {
 				return err
 			}
 
-			if newMnemonic != mnemonic {
+			output.Name = args[0]
+
+			// Split the generated mnemonic into shares instead of showing it
+			var shares []string
+			if shareCount > 0 {
+				shares, err = c.SplitMnemonic(mnemonic, shareThreshold, shareCount)
+				if err != nil {
+					return err
+				}
+			} else if !recovered {
 				writeMnemonicWarningToCmd(cmd)
-				output.Mnemonic = newMnemonic
+				output.Mnemonic = mnemonic
 			}
 
 			// Output the key information
@@ -110,6 +342,19 @@ func keysAdd() *cobra.Command {
 				return err
 			}
 
+			if len(shares) > 0 {
+				writeSharesWarningToCmd(cmd, shareThreshold)
+				for _, share := range shares {
+					cmd.Println(share)
+				}
+				cmd.Println()
+			}
+
+			if dryRun {
+				cmd.Println("Dry run, key was not stored.")
+				return nil
+			}
+
 			cmd.Println("Key created successfully.")
 			return nil
 		},
@@ -117,7 +362,94 @@ func keysAdd() *cobra.Command {
 
 	flags.AddKeyFlags(cmd)
 	flags.AddKeyringFlags(cmd)
-	flags.SetFlagOutputFormat(cmd)
+	flags.AddKeysMnemonicFlags(cmd)
+	flags.SetFlagKeysDryRun(cmd)
+	flags.SetFlagKeysMnemonicWordCount(cmd)
+	flags.SetFlagKeysRecover(cmd)
+	flags.SetFlagKeysShareCount(cmd)
+	flags.SetFlagKeysShareThreshold(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// keysDerive lists the keys derived from a mnemonic for a range of accounts and address indexes.
+func keysDerive() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derive",
+		Short: "List the keys derived from a mnemonic for a range of accounts and address indexes",
+		Long: `List the keys derived from a mnemonic without storing them. Accounts start at --key.account
+and address indexes start at --key.index. The mnemonic is read from --mnemonic-file or --mnemonic-env,
+or prompted for when neither is set.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			accountCount, err := flags.GetKeysAccountCount(cmd)
+			if err != nil {
+				return err
+			}
+
+			indexCount, err := flags.GetKeysIndexCount(cmd)
+			if err != nil {
+				return err
+			}
+
+			prompter := opts.GetPrompter()
+
+			mnemonic, prompted, err := readMnemonicFromCmd(cmd, prompter)
+			if err != nil {
+				return err
+			}
+
+			bip39Pass, err := readBIP39PassFromCmd(cmd, prompter, prompted)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Derive a key for every account and address index in the range
+			var keys []*keyring.Record
+			for account := opts.GetAccount(); account < opts.GetAccount()+accountCount; account++ {
+				for index := opts.GetIndex(); index < opts.GetIndex()+indexCount; index++ {
+					key := options.NewKey().
+						WithAccount(account).
+						WithCoinType(opts.GetCoinType()).
+						WithIndex(index)
+
+					record, err := c.DeriveKey(mnemonic, bip39Pass, key)
+					if err != nil {
+						return err
+					}
+
+					keys = append(keys, record)
+				}
+			}
+
+			output, err := keyring.MkAccKeysOutput(keys)
+			if err != nil {
+				return err
+			}
+
+			// Output the derived keys, named by their HD path
+			return writeOutputToCmd(cmd, output, outputFormat)
+		},
+	}
+
+	flags.AddKeyFlags(cmd)
+	flags.AddKeysMnemonicFlags(cmd)
+	flags.SetFlagKeysAccountCount(cmd)
+	flags.SetFlagKeysIndexCount(cmd)
+	flags.AddOutputFlags(cmd)
 
 	return cmd
 }
@@ -125,18 +457,19 @@ func keysAdd() *cobra.Command {
 // keysDelete removes the key with the specified name.
 func keysDelete() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delete [name]",
-		Short: "Delete the key with the specified name",
-		Args:  cobra.ExactArgs(1),
+		Use:               "delete [name]",
+		Short:             "Delete the key with the specified name",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeArgs(completeKeyNames),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts := client.NewOptions()
-			if _, err := opts.WithKeyringFromCmd(cmd); err != nil {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
 				return err
 			}
 
-			reader := bufio.NewReader(opts.Input)
+			prompter := opts.GetPrompter()
 
-			confirm, err := input.GetConfirmation("Are you sure you want to delete this key? [y/N]:", reader)
+			confirm, err := prompter.GetConfirmation("Are you sure you want to delete this key? [y/N]:")
 			if err != nil {
 				return err
 			}
@@ -168,8 +501,8 @@ func keysList() *cobra.Command {
 		Use:   "list",
 		Short: "List all available keys",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts := client.NewOptions()
-			if _, err := opts.WithKeyringFromCmd(cmd); err != nil {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
 				return err
 			}
 
@@ -202,7 +535,7 @@ func keysList() *cobra.Command {
 	}
 
 	flags.AddKeyringFlags(cmd)
-	flags.SetFlagOutputFormat(cmd)
+	flags.AddOutputFlags(cmd)
 
 	return cmd
 }
@@ -210,12 +543,13 @@ func keysList() *cobra.Command {
 // keysShow displays details of the key with the specified name.
 func keysShow() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show [name]",
-		Short: "Show details of the key with the specified name",
-		Args:  cobra.ExactArgs(1),
+		Use:               "show [name]",
+		Short:             "Show details of the key with the specified name",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeArgs(completeKeyNames),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts := client.NewOptions()
-			if _, err := opts.WithKeyringFromCmd(cmd); err != nil {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
 				return err
 			}
 
@@ -224,6 +558,11 @@ func keysShow() *cobra.Command {
 				return err
 			}
 
+			bech, err := flags.GetKeysBech(cmd)
+			if err != nil {
+				return err
+			}
+
 			// Initialize the Client
 			c := client.NewDefault()
 
@@ -238,6 +577,17 @@ func keysShow() *cobra.Command {
 				return err
 			}
 
+			// Encode the address with the requested Bech32 prefix
+			addr, err := key.GetAddress()
+			if err != nil {
+				return err
+			}
+
+			output.Address, err = utils.Bech32FromAddrBytes(bech, addr)
+			if err != nil {
+				return err
+			}
+
 			// Output the key details
 			if err := writeOutputToCmd(cmd, output, outputFormat); err != nil {
 				return err
@@ -248,7 +598,438 @@ func keysShow() *cobra.Command {
 	}
 
 	flags.AddKeyringFlags(cmd)
-	flags.SetFlagOutputFormat(cmd)
+	flags.SetFlagKeysBech(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// keysExport exports the private key with the specified name in ASCII-armored, passphrase-encrypted format.
+func keysExport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "export [name]",
+		Short:             "Export the private key with the specified name in ASCII-armored encrypted format",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeArgs(completeKeyNames),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			prompter := opts.GetPrompter()
+
+			// Prompt for the encryption passphrase
+			passphrase, err := prompter.GetPassword("Enter passphrase to encrypt the exported key:")
+			if err != nil {
+				return err
+			}
+
+			confirmPass, err := prompter.GetPassword("Confirm passphrase:")
+			if err != nil {
+				return err
+			}
+
+			if passphrase != confirmPass {
+				return errors.New("passphrase does not match")
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Export the key
+			armor, err := c.ExportKey(args[0], passphrase, opts)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(armor)
+			return nil
+		},
+	}
+
+	flags.AddKeyringFlags(cmd)
+
+	return cmd
+}
+
+// keysImport imports an ASCII-armored, passphrase-encrypted private key from a file.
+func keysImport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [name] [file]",
+		Short: "Import an ASCII-armored encrypted private key from a file under the specified name",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			armor, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			prompter := opts.GetPrompter()
+
+			// Prompt for the decryption passphrase
+			passphrase, err := prompter.GetPassword("Enter passphrase to decrypt the key:")
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Import the key
+			key, err := c.ImportKey(args[0], string(armor), passphrase, opts)
+			if err != nil {
+				return err
+			}
+
+			output, err := keyring.MkAccKeyOutput(key)
+			if err != nil {
+				return err
+			}
+
+			// Output the key information
+			if err := writeOutputToCmd(cmd, output, outputFormat); err != nil {
+				return err
+			}
+
+			cmd.Println("Key imported successfully.")
+			return nil
+		},
+	}
+
+	flags.AddKeyringFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// keysImportHex imports a hex encoded secp256k1 private key.
+func keysImportHex() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-hex [name]",
+		Short: "Import a hex encoded secp256k1 private key under the specified name",
+		Long: `Import a hex encoded secp256k1 private key under the specified name. The private key is read from
+--private-key-file or --private-key-env, or prompted for without echoing when neither is set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			privKey, err := readPrivKeyHexFromCmd(cmd, opts.GetPrompter())
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Import the key
+			key, err := c.ImportKeyHex(args[0], privKey, string(hd.Secp256k1Type), opts)
+			if err != nil {
+				return err
+			}
+
+			output, err := keyring.MkAccKeyOutput(key)
+			if err != nil {
+				return err
+			}
+
+			// Output the key information
+			if err := writeOutputToCmd(cmd, output, outputFormat); err != nil {
+				return err
+			}
+
+			cmd.Println("Key imported successfully.")
+			return nil
+		},
+	}
+
+	flags.AddKeyringFlags(cmd)
+	flags.AddKeysPrivKeyFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// keysMigrate copies every key to a keyring with a different backend.
+func keysMigrate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [dst-backend]",
+		Short: "Copy every key to a keyring with the specified backend",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			dstHomeDir, err := flags.GetKeysDstHomeDir(cmd)
+			if err != nil {
+				return err
+			}
+			if dstHomeDir == "" {
+				dstHomeDir = opts.GetHomeDir()
+			}
+
+			// Build the destination keyring options from the source options
+			dst := options.NewKeyring().
+				WithAppName(opts.GetAppName()).
+				WithBackend(args[0]).
+				WithHomeDir(dstHomeDir).
+				WithInput(opts.GetInput()).
+				WithPrompter(opts.GetPrompter())
+			if err := dst.Validate(); err != nil {
+				return err
+			}
+			if dst.GetBackend() == opts.GetBackend() && dst.GetHomeDir() == opts.GetHomeDir() {
+				return errors.New("destination keyring must differ from the source keyring")
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Migrate the keys
+			keys, err := c.MigrateKeys(dst, opts)
+			if err != nil {
+				return err
+			}
+
+			output, err := keyring.MkAccKeysOutput(keys)
+			if err != nil {
+				return err
+			}
+
+			// Output the migrated keys
+			if err := writeOutputToCmd(cmd, output, outputFormat); err != nil {
+				return err
+			}
+
+			cmd.Println("Keys migrated successfully. The source keyring was left unchanged.")
+			return nil
+		},
+	}
+
+	flags.AddKeyringFlags(cmd)
+	flags.SetFlagKeysDstHomeDir(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// keysRecoverShares reconstructs a mnemonic from Shamir shares and creates a key from it.
+func keysRecoverShares() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recover-shares [name]",
+		Short: "Recover a key with the specified name from Shamir shares of its mnemonic",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			prompter := opts.GetPrompter()
+
+			// Prompt for shares until the threshold of the first share is reached
+			var (
+				first  *shamir.Share
+				shares []string
+			)
+			for threshold := 1; len(shares) < threshold; {
+				v, err := prompter.GetString(fmt.Sprintf("Enter share %d:", len(shares)+1))
+				if err != nil {
+					return err
+				}
+
+				share, err := shamir.ParseShare(v)
+				if err != nil {
+					return err
+				}
+				if first == nil {
+					first = share
+				} else if share.ID != first.ID {
+					return errors.New("share does not belong to the same split as the first share")
+				}
+
+				threshold = int(share.Threshold)
+				shares = append(shares, v)
+			}
+
+			bip39Pass, err := readBIP39PassFromCmd(cmd, prompter, true)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Reconstruct the mnemonic from the shares
+			mnemonic, err := c.CombineMnemonicShares(shares)
+			if err != nil {
+				return err
+			}
+
+			// Check if the key already exists
+			if _, err := c.Key(args[0], opts); err == nil {
+				return fmt.Errorf("key with name '%s' already exists", args[0])
+			}
+
+			// Create the key
+			_, key, err := c.CreateKey(args[0], mnemonic, bip39Pass, opts)
+			if err != nil {
+				return err
+			}
+
+			output, err := keyring.MkAccKeyOutput(key)
+			if err != nil {
+				return err
+			}
+
+			// Output the key information
+			if err := writeOutputToCmd(cmd, output, outputFormat); err != nil {
+				return err
+			}
+
+			cmd.Println("Key recovered successfully.")
+			return nil
+		},
+	}
+
+	flags.AddKeyFlags(cmd)
+	flags.AddKeyringFlags(cmd)
+	flags.SetFlagKeysBIP39PassEnv(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// keysRename renames the key with the specified name.
+func keysRename() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rename [old-name] [new-name]",
+		Short: "Rename the key with the specified name",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Rename the key
+			if err := c.RenameKey(args[0], args[1], opts); err != nil {
+				return err
+			}
+
+			cmd.Println("Key renamed successfully.")
+			return nil
+		},
+	}
+
+	flags.AddKeyringFlags(cmd)
+
+	return cmd
+}
+
+// keysSign signs arbitrary data off-chain with the key of the specified name, following ADR-036.
+func keysSign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "sign [name] [data]",
+		Short:             "Sign arbitrary data off-chain with the key of the specified name (ADR-036)",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeArgs(completeKeyNames),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Sign the data
+			sig, err := c.SignArbitrary(args[0], []byte(args[1]), opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the signature as JSON so it can be passed to keys verify
+			return writeOutputToCmd(cmd, sig, keys.OutputFormatJSON)
+		},
+	}
+
+	flags.AddKeyringFlags(cmd)
+
+	return cmd
+}
+
+// keysVerify verifies an ADR-036 signature read from a JSON file.
+func keysVerify() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [file]",
+		Short: "Verify an ADR-036 signature read from a JSON file, or from stdin if the file is -",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				buf []byte
+				err error
+			)
+
+			if args[0] == "-" {
+				buf, err = io.ReadAll(cmd.InOrStdin())
+			} else {
+				buf, err = os.ReadFile(args[0])
+			}
+			if err != nil {
+				return err
+			}
+
+			var sig client.ArbitrarySignature
+			if err := json.Unmarshal(buf, &sig); err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Verify the signature
+			if err := c.VerifyArbitrary(&sig); err != nil {
+				return err
+			}
+
+			cmd.Printf("Signature is valid for signer %s.\n", sig.Signer)
+			return nil
+		},
+	}
 
 	return cmd
 }
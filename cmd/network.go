@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// NetworkCmd returns a new Cobra command for network profile sub-commands.
+func NetworkCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "network",
+		Short: "Sub-commands for listing network profiles.",
+		Long: `Sub-commands for listing network profiles. The built-in profiles are mainnet and local.
+User-defined profiles are read from networks.toml, networks.yaml, networks.yml or networks.json in the home
+directory, as a table of profiles keyed by name, and replace built-in profiles with the same name.
+A profile holds chain_id, rpc_addrs, grpc_addr, gas_prices and denom. Queries are sent to the first RPC server
+and fail over to the others, and gas_prices may be an amount in denom, such as "0.1".
+There is no built-in testnet profile; a testnet is added as a user-defined profile.
+A profile is selected with the --network flag or the SENTINEL_NETWORK environment variable.`,
+	}
+
+	cmd.AddCommand(
+		networkList(),
+		networkShow(),
+	)
+
+	flags.SetPersistentFlagHomeDir(cmd)
+
+	return cmd
+}
+
+// networkList lists all network profiles.
+func networkList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the built-in and user-defined network profiles",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			homeDir, err := client.HomeDirFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			networks, err := client.LoadNetworks(homeDir)
+			if err != nil {
+				return err
+			}
+
+			// Output the network profiles sorted by name
+			return writeOutputToCmd(cmd, client.SortedNetworks(networks), outputFormat)
+		},
+	}
+
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// networkShow displays a network profile.
+func networkShow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "show [name]",
+		Short:             "Show the network profile with the specified name",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeArgs(completeNetworkNames),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			homeDir, err := client.HomeDirFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			networks, err := client.LoadNetworks(homeDir)
+			if err != nil {
+				return err
+			}
+
+			network, ok := networks[args[0]]
+			if !ok {
+				return fmt.Errorf("unknown network %s", args[0])
+			}
+
+			// Output the network profile
+			return writeOutputToCmd(cmd, network, outputFormat)
+		},
+	}
+
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
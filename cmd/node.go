@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+	"github.com/sentinel-official/sentinel-go-sdk/libs/log"
+	"github.com/sentinel-official/sentinel-go-sdk/node"
+)
+
+// NodeCmd returns a new Cobra command for dVPN node sub-commands.
+func NodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node",
+		Short: "Sub-commands for running a dVPN node.",
+	}
+
+	cmd.AddCommand(
+		nodeStart(),
+	)
+
+	flags.SetPersistentFlagHomeDir(cmd)
+	flags.SetPersistentFlagNetwork(cmd)
+
+	return cmd
+}
+
+// nodeStart runs a dVPN node until it is interrupted or terminated.
+func nodeStart() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Run a dVPN node with the configuration in the home directory",
+		Long: `Run a dVPN node with the node configuration file (node.toml, node.yaml, node.yml or node.json) in the
+home directory. The configured service is brought up, the node API is served, and the node status and the
+sessions of its peers are updated on the chain, signed with the key specified by --tx.from-name.
+On interrupt or termination, the node is marked inactive and the service is brought down.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			homeDir, err := client.HomeDirFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			logger, err := log.NewLoggerFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := node.LoadConfig(homeDir)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client and the node
+			c := client.NewDefault()
+
+			n, err := node.New(homeDir, cfg, c, opts, logger)
+			if err != nil {
+				return err
+			}
+
+			// Stop the node on interrupt or termination
+			ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+
+			return n.Run(ctx)
+		},
+	}
+
+	flags.AddKeyringFlags(cmd)
+	flags.AddLogFlags(cmd)
+	flags.AddQueryFlags(cmd)
+	flags.AddTxFlags(cmd)
+	registerFlagCompletionFromName(cmd)
+
+	return cmd
+}
@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// addTxCmdFlags adds the flags required by commands that broadcast a transaction, completing the key names of tx.from-name.
+func addTxCmdFlags(cmd *cobra.Command) {
+	flags.AddKeyringFlags(cmd)
+	flags.AddQueryFlags(cmd)
+	flags.AddTxFlags(cmd)
+	registerFlagCompletionFromName(cmd)
+	flags.AddOutputFlags(cmd)
+}
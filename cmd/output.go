@@ -1,13 +1,26 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/denoms"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
 )
 
 // writeOutputJSON formats the output as JSON and writes it to the provided writer.
@@ -32,6 +45,206 @@ func writeOutputText(w io.Writer, v interface{}) error {
 	return err
 }
 
+// marshalProtoJSON encodes the value as JSON, using the protobuf JSON encoding of the client codec
+// for protobuf messages and for the elements of slices of protobuf messages.
+func marshalProtoJSON(cdc codec.JSONCodec, v interface{}) ([]byte, error) {
+	if msg, ok := v.(proto.Message); ok {
+		return cdc.MarshalJSON(msg)
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() && rv.Elem().Kind() == reflect.Slice {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Slice || rv.Type().Elem().Kind() == reflect.Uint8 {
+		return json.Marshal(v)
+	}
+
+	// Encode each element, taking the address of elements whose pointer is a protobuf message.
+	items := make([]json.RawMessage, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		item := rv.Index(i)
+		if item.CanAddr() && item.Kind() != reflect.Interface && item.Kind() != reflect.Ptr {
+			item = item.Addr()
+		}
+
+		buf, err := marshalProtoJSON(cdc, item.Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, buf)
+	}
+
+	return json.Marshal(items)
+}
+
+// writeOutputProtoJSON formats the output as indented protobuf JSON and writes it to the provided writer.
+func writeOutputProtoJSON(w io.Writer, v interface{}) error {
+	buf, err := marshalProtoJSON(client.NewDefault(), v)
+	if err != nil {
+		return err
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, buf, "", "  "); err != nil {
+		return err
+	}
+
+	_, err = out.WriteTo(w)
+	return err
+}
+
+// decodeJSONObject decodes a JSON object into its keys, in their original order, and raw values.
+// It reports false if the input is not a JSON object.
+func decodeJSONObject(buf []byte) ([]string, map[string]json.RawMessage, bool) {
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, nil, false
+	}
+
+	var (
+		keys   []string
+		values = make(map[string]json.RawMessage)
+	)
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, false
+		}
+
+		key, ok := tok.(string)
+		if !ok {
+			return nil, nil, false
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, nil, false
+		}
+
+		keys = append(keys, key)
+		values[key] = value
+	}
+
+	return keys, values, true
+}
+
+// jsonCell renders a raw JSON value as a table cell. Strings are unquoted, null is empty,
+// coins are rendered in their short form, and other values are compacted.
+func jsonCell(v json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(v, &s); err == nil {
+		return s
+	}
+	if string(v) == "null" {
+		return ""
+	}
+
+	var coins cosmossdk.Coins
+	if err := json.Unmarshal(v, &coins); err == nil && coins.Validate() == nil {
+		return coins.String()
+	}
+
+	var decCoins cosmossdk.DecCoins
+	if err := json.Unmarshal(v, &decCoins); err == nil && decCoins.Validate() == nil {
+		return decCoins.String()
+	}
+
+	var out bytes.Buffer
+	if err := json.Compact(&out, v); err != nil {
+		return string(v)
+	}
+
+	return out.String()
+}
+
+// outputRows converts the output into a header and rows. A list becomes one row per item
+// and any other value a single row. The columns are the top-level fields of the protobuf JSON encoding.
+func outputRows(v interface{}) ([]string, [][]string, error) {
+	buf, err := marshalProtoJSON(client.NewDefault(), v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(buf, &items); err != nil {
+		items = []json.RawMessage{buf}
+	}
+
+	var (
+		header  []string
+		columns = make(map[string]int)
+		objects = make([]map[string]json.RawMessage, 0, len(items))
+	)
+
+	// Collect the union of the fields of all items, in the order they first appear.
+	for _, item := range items {
+		keys, values, ok := decodeJSONObject(item)
+		if !ok {
+			keys, values = []string{"value"}, map[string]json.RawMessage{"value": item}
+		}
+
+		for _, key := range keys {
+			if _, ok := columns[key]; !ok {
+				columns[key] = len(header)
+				header = append(header, key)
+			}
+		}
+
+		objects = append(objects, values)
+	}
+
+	rows := make([][]string, 0, len(objects))
+	for _, object := range objects {
+		row := make([]string, len(header))
+		for key, value := range object {
+			row[columns[key]] = jsonCell(value)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return header, rows, nil
+}
+
+// writeOutputTable formats the output as a column-aligned table and writes it to the provided writer.
+func writeOutputTable(w io.Writer, v interface{}) error {
+	header, rows, err := outputRows(v)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, strings.ToUpper(strings.Join(header, "\t"))); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
+
+// writeOutputCSV formats the output as CSV with a header row and writes it to the provided writer.
+func writeOutputCSV(w io.Writer, v interface{}) error {
+	header, rows, err := outputRows(v)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	return cw.WriteAll(rows)
+}
+
 // writeOutput formats the output according to the specified format and writes it to the provided writer.
 func writeOutput(w io.Writer, v interface{}, format string) error {
 	switch format {
@@ -39,13 +252,202 @@ func writeOutput(w io.Writer, v interface{}, format string) error {
 		return writeOutputJSON(w, v)
 	case keys.OutputFormatText:
 		return writeOutputText(w, v)
+	case flags.OutputFormatProtoJSON:
+		return writeOutputProtoJSON(w, v)
+	case flags.OutputFormatTable:
+		return writeOutputTable(w, v)
+	case flags.OutputFormatCSV:
+		return writeOutputCSV(w, v)
 	default:
 		return fmt.Errorf("invalid output format: %s", format)
 	}
 }
 
+// isCoinObject reports whether the decoded JSON object is a coin, with only a denom and an amount.
+func isCoinObject(m map[string]interface{}) (string, string, bool) {
+	if len(m) != 2 {
+		return "", "", false
+	}
+
+	denom, ok := m["denom"].(string)
+	if !ok {
+		return "", "", false
+	}
+
+	amount, ok := m["amount"].(string)
+	if !ok {
+		return "", "", false
+	}
+
+	return denom, amount, true
+}
+
+// displayCoin converts a coin in base units to display units, returning false for coins of unknown denoms.
+func displayCoin(r *denoms.Registry, denom, amount string) (string, string, bool) {
+	_, known := r.Metadata(denom)
+	_, traced := r.Trace(denom)
+	if !known && !traced {
+		return "", "", false
+	}
+
+	v, err := sdkmath.LegacyNewDecFromStr(amount)
+	if err != nil || v.IsNegative() {
+		return "", "", false
+	}
+
+	coin := r.ToDisplayDec(cosmossdk.NewDecCoinFromDec(denom, v))
+	return coin.Denom, denoms.FormatDec(coin.Amount), true
+}
+
+// displayValue converts the coins in the decoded JSON value to display units. If flatten is true, coins and
+// lists of coins are replaced by their short form, such as "0.5dvpn", and otherwise kept as objects.
+// Integer numbers are decoded, so the value is rendered as numbers rather than strings.
+func displayValue(r *denoms.Registry, v interface{}, flatten bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if denom, amount, ok := isCoinObject(v); ok {
+			if d, a, ok := displayCoin(r, denom, amount); ok {
+				denom, amount = d, a
+			}
+			if flatten {
+				return amount + denom
+			}
+
+			return map[string]interface{}{"amount": amount, "denom": denom}
+		}
+
+		for key, item := range v {
+			v[key] = displayValue(r, item, flatten)
+		}
+
+		return v
+	case []interface{}:
+		coins := len(v) > 0
+		for i, item := range v {
+			if m, ok := item.(map[string]interface{}); !ok {
+				coins = false
+			} else if _, _, ok := isCoinObject(m); !ok {
+				coins = false
+			}
+
+			v[i] = displayValue(r, item, flatten)
+		}
+
+		// Join the short forms of a list of coins.
+		if flatten && coins {
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				items = append(items, item.(string))
+			}
+
+			return strings.Join(items, ",")
+		}
+
+		return v
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+// decodeOutput encodes the output as JSON, or protobuf JSON for the proto-json, table and csv formats,
+// and decodes it into generic values, so the amounts it holds can be converted to display units.
+func decodeOutput(v interface{}, format string) (interface{}, error) {
+	var (
+		buf []byte
+		err error
+	)
+
+	switch format {
+	case keys.OutputFormatJSON, keys.OutputFormatText:
+		buf, err = json.Marshal(v)
+	default:
+		buf, err = marshalProtoJSON(client.NewDefault(), v)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var out interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ibcDenoms returns the IBC denoms of the coins in the decoded JSON value.
+func ibcDenoms(v interface{}) []string {
+	var res []string
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if denom, _, ok := isCoinObject(v); ok {
+			if strings.HasPrefix(denom, "ibc/") {
+				res = append(res, denom)
+			}
+
+			return res
+		}
+
+		for _, item := range v {
+			res = append(res, ibcDenoms(item)...)
+		}
+	case []interface{}:
+		for _, item := range v {
+			res = append(res, ibcDenoms(item)...)
+		}
+	}
+
+	return res
+}
+
+// registerDenomsFromCmd adds the denoms metadata of the chain and the traces of the given IBC denoms to the
+// default registry, for commands querying the chain. Failures are ignored, leaving the known denoms in use.
+func registerDenomsFromCmd(cmd *cobra.Command, ibcDenoms []string) {
+	if cmd.Flags().Lookup("query.rpc-addr") == nil {
+		return
+	}
+
+	opts, err := client.NewFromCmd(cmd)
+	if err != nil {
+		return
+	}
+
+	// Initialize the Client
+	c := client.NewDefault()
+
+	_ = c.RegisterDenomsMetadata(cmd.Context(), denoms.DefaultRegistry, opts)
+	_ = c.RegisterDenomTraces(cmd.Context(), denoms.DefaultRegistry, ibcDenoms, opts)
+}
+
 // writeOutputToCmd writes the formatted output to the command's output and adds a newline.
+// If the output display units flag is set, amounts of known denoms are written in display units,
+// and IBC denoms are written with the base denom they were transferred from.
 func writeOutputToCmd(cmd *cobra.Command, v interface{}, format string) error {
+	displayUnits, err := flags.GetOutputDisplayUnits(cmd)
+	if err != nil {
+		return err
+	}
+	if displayUnits {
+		out, err := decodeOutput(v, format)
+		if err != nil {
+			return err
+		}
+
+		registerDenomsFromCmd(cmd, ibcDenoms(out))
+
+		flatten := format == flags.OutputFormatTable || format == flags.OutputFormatCSV
+		v = displayValue(denoms.DefaultRegistry, out, flatten)
+	}
+
 	if err := writeOutput(cmd.OutOrStderr(), v, format); err != nil {
 		return err
 	}
@@ -64,3 +466,14 @@ func writeMnemonicWarningToCmd(cmd *cobra.Command) {
 	cmd.Printf("####################################################################\n")
 	cmd.Printf("\n")
 }
+
+// writeSharesWarningToCmd prints a formatted warning message to store the mnemonic shares separately.
+func writeSharesWarningToCmd(cmd *cobra.Command, threshold int) {
+	cmd.Printf("\n")
+	cmd.Printf("####################################################################\n")
+	cmd.Printf("WARNING: YOU MUST SAVE EACH OF THE FOLLOWING SHARES SECURELY!\n")
+	cmd.Printf("ANY %d OF THESE SHARES ARE REQUIRED TO RECOVER YOUR KEY.\n", threshold)
+	cmd.Printf("STORE EACH SHARE IN A SEPARATE LOCATION.\n")
+	cmd.Printf("####################################################################\n")
+	cmd.Printf("\n")
+}
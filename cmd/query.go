@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// QueryCmd returns a new Cobra command for querying the state of the hub modules.
+func QueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Sub-commands for querying nodes, plans, providers, sessions, subscriptions and leases.",
+	}
+
+	cmd.AddCommand(
+		queryNode(),
+		queryNodes(),
+		queryNodesForPlan(),
+		queryPlan(),
+		queryPlans(),
+		queryPlansForProvider(),
+		queryProvider(),
+		queryProviders(),
+		querySession(),
+		querySessions(),
+		querySessionsForAccount(),
+		querySessionsForNode(),
+		querySessionsForSubscription(),
+		querySessionsForAllocation(),
+		querySubscription(),
+		querySubscriptions(),
+		querySubscriptionsForAccount(),
+		querySubscriptionsForPlan(),
+		queryAllocation(),
+		queryAllocations(),
+		queryLease(),
+		queryLeases(),
+		queryLeasesForNode(),
+		queryLeasesForProvider(),
+	)
+
+	addWatchToCmds(cmd.Commands()...)
+
+	flags.AddPersistentWatchFlags(cmd)
+	flags.SetPersistentFlagHomeDir(cmd)
+	flags.SetPersistentFlagNetwork(cmd)
+
+	return cmd
+}
+
+// addPageQueryCmdFlags adds the flags required by commands that query a paginated list.
+func addPageQueryCmdFlags(cmd *cobra.Command) {
+	flags.AddPageFlags(cmd)
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+	flags.SetFlagPageAll(cmd)
+}
+
+// queryPages calls fn with the page options of the command, or collects every page
+// if the "all" flag is set.
+func queryPages[T any](cmd *cobra.Command, opts *client.Options, fn func(*client.Options) ([]T, error)) ([]T, error) {
+	all, err := flags.GetPageAll(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if all {
+		return client.QueryAll(opts, fn)
+	}
+
+	return fn(opts)
+}
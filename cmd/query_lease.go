@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"strconv"
+
+	base "github.com/sentinel-official/hub/v12/types"
+	"github.com/sentinel-official/hub/v12/x/lease/types/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// queryLease displays the details of the lease with the specified ID.
+func queryLease() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lease [id]",
+		Short: "Show details of the lease with the specified ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the lease details
+			lease, err := c.Lease(cmd.Context(), id, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the lease details
+			return writeOutputToCmd(cmd, lease, outputFormat)
+		},
+	}
+
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// queryLeases lists the leases.
+func queryLeases() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leases",
+		Short: "List leases",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of leases
+			leases, err := queryPages(cmd, opts, func(opts *client.Options) ([]v1.Lease, error) {
+				return c.Leases(cmd.Context(), opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the lease list
+			return writeOutputToCmd(cmd, leases, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+
+	return cmd
+}
+
+// queryLeasesForNode lists the leases of the node with the specified address.
+func queryLeasesForNode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "leases-for-node [node-addr]",
+		Short:             "List leases of the node with the specified address",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeArgs(completeNodeAddrs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			nodeAddr, err := base.NodeAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of leases
+			leases, err := queryPages(cmd, opts, func(opts *client.Options) ([]v1.Lease, error) {
+				return c.LeasesForNode(cmd.Context(), nodeAddr, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the lease list
+			return writeOutputToCmd(cmd, leases, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+
+	return cmd
+}
+
+// queryLeasesForProvider lists the leases of the provider with the specified address.
+func queryLeasesForProvider() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leases-for-provider [prov-addr]",
+		Short: "List leases of the provider with the specified address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			provAddr, err := base.ProvAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of leases
+			leases, err := queryPages(cmd, opts, func(opts *client.Options) ([]v1.Lease, error) {
+				return c.LeasesForProvider(cmd.Context(), provAddr, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the lease list
+			return writeOutputToCmd(cmd, leases, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+
+	return cmd
+}
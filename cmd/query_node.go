@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	base "github.com/sentinel-official/hub/v12/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	"github.com/sentinel-official/hub/v12/x/node/types/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// queryNode displays the details of the node with the specified address.
+func queryNode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "node [node-addr]",
+		Short:             "Show details of the node with the specified address",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeArgs(completeNodeAddrs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			nodeAddr, err := base.NodeAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the node details
+			node, err := c.Node(cmd.Context(), nodeAddr, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the node details
+			return writeOutputToCmd(cmd, node, outputFormat)
+		},
+	}
+
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// queryNodes lists the nodes, optionally filtered by status.
+func queryNodes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nodes",
+		Short: "List nodes, optionally filtered by status",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := flags.GetHubStatus(cmd)
+			if err != nil {
+				return err
+			}
+
+			status := v1base.StatusFromString(statusStr)
+			if statusStr != "" && !status.IsValid() {
+				return fmt.Errorf("invalid status %s", statusStr)
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of nodes
+			nodes, err := queryPages(cmd, opts, func(opts *client.Options) ([]v2.Node, error) {
+				return c.Nodes(cmd.Context(), status, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the node list
+			return writeOutputToCmd(cmd, nodes, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+	flags.SetFlagHubStatus(cmd)
+
+	return cmd
+}
+
+// queryNodesForPlan lists the nodes of the plan with the specified ID, optionally filtered by status.
+func queryNodesForPlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "nodes-for-plan [id]",
+		Short:             "List nodes of the plan with the specified ID, optionally filtered by status",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeArgs(completePlanIDs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := flags.GetHubStatus(cmd)
+			if err != nil {
+				return err
+			}
+
+			status := v1base.StatusFromString(statusStr)
+			if statusStr != "" && !status.IsValid() {
+				return fmt.Errorf("invalid status %s", statusStr)
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of nodes
+			nodes, err := queryPages(cmd, opts, func(opts *client.Options) ([]v2.Node, error) {
+				return c.NodesForPlan(cmd.Context(), id, status, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the node list
+			return writeOutputToCmd(cmd, nodes, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+	flags.SetFlagHubStatus(cmd)
+
+	return cmd
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	base "github.com/sentinel-official/hub/v12/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	"github.com/sentinel-official/hub/v12/x/plan/types/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// queryPlan displays the details of the plan with the specified ID.
+func queryPlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "plan [id]",
+		Short:             "Show details of the plan with the specified ID",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeArgs(completePlanIDs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the plan details
+			plan, err := c.Plan(cmd.Context(), id, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the plan details
+			return writeOutputToCmd(cmd, plan, outputFormat)
+		},
+	}
+
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// queryPlans lists the plans, optionally filtered by status.
+func queryPlans() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plans",
+		Short: "List plans, optionally filtered by status",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := flags.GetHubStatus(cmd)
+			if err != nil {
+				return err
+			}
+
+			status := v1base.StatusFromString(statusStr)
+			if statusStr != "" && !status.IsValid() {
+				return fmt.Errorf("invalid status %s", statusStr)
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of plans
+			plans, err := queryPages(cmd, opts, func(opts *client.Options) ([]v2.Plan, error) {
+				return c.Plans(cmd.Context(), status, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the plan list
+			return writeOutputToCmd(cmd, plans, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+	flags.SetFlagHubStatus(cmd)
+
+	return cmd
+}
+
+// queryPlansForProvider lists the plans of the provider with the specified address, optionally filtered by status.
+func queryPlansForProvider() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plans-for-provider [prov-addr]",
+		Short: "List plans of the provider with the specified address, optionally filtered by status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			provAddr, err := base.ProvAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := flags.GetHubStatus(cmd)
+			if err != nil {
+				return err
+			}
+
+			status := v1base.StatusFromString(statusStr)
+			if statusStr != "" && !status.IsValid() {
+				return fmt.Errorf("invalid status %s", statusStr)
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of plans
+			plans, err := queryPages(cmd, opts, func(opts *client.Options) ([]v2.Plan, error) {
+				return c.PlansForProvider(cmd.Context(), provAddr, status, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the plan list
+			return writeOutputToCmd(cmd, plans, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+	flags.SetFlagHubStatus(cmd)
+
+	return cmd
+}
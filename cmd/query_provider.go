@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+
+	base "github.com/sentinel-official/hub/v12/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	"github.com/sentinel-official/hub/v12/x/provider/types/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// queryProvider displays the details of the provider with the specified address.
+func queryProvider() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider [prov-addr]",
+		Short: "Show details of the provider with the specified address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			provAddr, err := base.ProvAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the provider details
+			provider, err := c.Provider(cmd.Context(), provAddr, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the provider details
+			return writeOutputToCmd(cmd, provider, outputFormat)
+		},
+	}
+
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// queryProviders lists the providers, optionally filtered by status.
+func queryProviders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "providers",
+		Short: "List providers, optionally filtered by status",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := flags.GetHubStatus(cmd)
+			if err != nil {
+				return err
+			}
+
+			status := v1base.StatusFromString(statusStr)
+			if statusStr != "" && !status.IsValid() {
+				return fmt.Errorf("invalid status %s", statusStr)
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of providers
+			providers, err := queryPages(cmd, opts, func(opts *client.Options) ([]v2.Provider, error) {
+				return c.Providers(cmd.Context(), status, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the provider list
+			return writeOutputToCmd(cmd, providers, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+	flags.SetFlagHubStatus(cmd)
+
+	return cmd
+}
@@ -0,0 +1,270 @@
+package cmd
+
+import (
+	"strconv"
+
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	base "github.com/sentinel-official/hub/v12/types"
+	"github.com/sentinel-official/hub/v12/x/session/types/v3"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// querySession displays the details of the session with the specified ID.
+func querySession() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "session [id]",
+		Short: "Show details of the session with the specified ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the session details
+			session, err := c.Session(cmd.Context(), id, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the session details
+			return writeOutputToCmd(cmd, session, outputFormat)
+		},
+	}
+
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// querySessions lists the sessions.
+func querySessions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sessions",
+		Short: "List sessions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of sessions
+			sessions, err := queryPages(cmd, opts, func(opts *client.Options) ([]v3.Session, error) {
+				return c.Sessions(cmd.Context(), opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the session list
+			return writeOutputToCmd(cmd, sessions, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+
+	return cmd
+}
+
+// querySessionsForAccount lists the sessions of the account with the specified address.
+func querySessionsForAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sessions-for-account [acc-addr]",
+		Short: "List sessions of the account with the specified address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			accAddr, err := cosmossdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of sessions
+			sessions, err := queryPages(cmd, opts, func(opts *client.Options) ([]v3.Session, error) {
+				return c.SessionsForAccount(cmd.Context(), accAddr, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the session list
+			return writeOutputToCmd(cmd, sessions, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+
+	return cmd
+}
+
+// querySessionsForNode lists the sessions of the node with the specified address.
+func querySessionsForNode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "sessions-for-node [node-addr]",
+		Short:             "List sessions of the node with the specified address",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeArgs(completeNodeAddrs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			nodeAddr, err := base.NodeAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of sessions
+			sessions, err := queryPages(cmd, opts, func(opts *client.Options) ([]v3.Session, error) {
+				return c.SessionsForNode(cmd.Context(), nodeAddr, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the session list
+			return writeOutputToCmd(cmd, sessions, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+
+	return cmd
+}
+
+// querySessionsForSubscription lists the sessions of the subscription with the specified ID.
+func querySessionsForSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sessions-for-subscription [id]",
+		Short: "List sessions of the subscription with the specified ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of sessions
+			sessions, err := queryPages(cmd, opts, func(opts *client.Options) ([]v3.Session, error) {
+				return c.SessionsForSubscription(cmd.Context(), id, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the session list
+			return writeOutputToCmd(cmd, sessions, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+
+	return cmd
+}
+
+// querySessionsForAllocation lists the sessions of the allocation of an account within the subscription with the specified ID.
+func querySessionsForAllocation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sessions-for-allocation [id] [acc-addr]",
+		Short: "List sessions of the allocation of an account within a subscription",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			accAddr, err := cosmossdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of sessions
+			sessions, err := queryPages(cmd, opts, func(opts *client.Options) ([]v3.Session, error) {
+				return c.SessionsForSubscriptionAllocation(cmd.Context(), id, accAddr, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the session list
+			return writeOutputToCmd(cmd, sessions, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,269 @@
+package cmd
+
+import (
+	"strconv"
+
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sentinel-official/hub/v12/x/subscription/types/v2"
+	"github.com/sentinel-official/hub/v12/x/subscription/types/v3"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// querySubscription displays the details of the subscription with the specified ID.
+func querySubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscription [id]",
+		Short: "Show details of the subscription with the specified ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the subscription details
+			subscription, err := c.Subscription(cmd.Context(), id, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the subscription details
+			return writeOutputToCmd(cmd, subscription, outputFormat)
+		},
+	}
+
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// querySubscriptions lists the subscriptions.
+func querySubscriptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscriptions",
+		Short: "List subscriptions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of subscriptions
+			subscriptions, err := queryPages(cmd, opts, func(opts *client.Options) ([]v3.Subscription, error) {
+				return c.Subscriptions(cmd.Context(), opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the subscription list
+			return writeOutputToCmd(cmd, subscriptions, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+
+	return cmd
+}
+
+// querySubscriptionsForAccount lists the subscriptions of the account with the specified address.
+func querySubscriptionsForAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscriptions-for-account [acc-addr]",
+		Short: "List subscriptions of the account with the specified address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			accAddr, err := cosmossdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of subscriptions
+			subscriptions, err := queryPages(cmd, opts, func(opts *client.Options) ([]v3.Subscription, error) {
+				return c.SubscriptionsForAccount(cmd.Context(), accAddr, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the subscription list
+			return writeOutputToCmd(cmd, subscriptions, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+
+	return cmd
+}
+
+// querySubscriptionsForPlan lists the subscriptions of the plan with the specified ID.
+func querySubscriptionsForPlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "subscriptions-for-plan [id]",
+		Short:             "List subscriptions of the plan with the specified ID",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeArgs(completePlanIDs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of subscriptions
+			subscriptions, err := queryPages(cmd, opts, func(opts *client.Options) ([]v3.Subscription, error) {
+				return c.SubscriptionsForPlan(cmd.Context(), id, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the subscription list
+			return writeOutputToCmd(cmd, subscriptions, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+
+	return cmd
+}
+
+// queryAllocation displays the details of the allocation of an account within the subscription with the specified ID.
+func queryAllocation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allocation [id] [acc-addr]",
+		Short: "Show details of the allocation of an account within a subscription",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			accAddr, err := cosmossdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the allocation details
+			allocation, err := c.SubscriptionAllocation(cmd.Context(), id, accAddr, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the allocation details
+			return writeOutputToCmd(cmd, allocation, outputFormat)
+		},
+	}
+
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// queryAllocations lists the allocations of the subscription with the specified ID.
+func queryAllocations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allocations [id]",
+		Short: "List allocations of the subscription with the specified ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of allocations
+			allocations, err := queryPages(cmd, opts, func(opts *client.Options) ([]v2.Allocation, error) {
+				return c.SubscriptionAllocations(cmd.Context(), id, opts)
+			})
+			if err != nil {
+				return err
+			}
+
+			// Output the allocation list
+			return writeOutputToCmd(cmd, allocations, outputFormat)
+		},
+	}
+
+	addPageQueryCmdFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+	"github.com/sentinel-official/sentinel-go-sdk/libs/signer"
+)
+
+// SignerCmd returns a new Cobra command for remote signer sub-commands.
+func SignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer",
+		Short: "Sub-commands for running a remote signer.",
+	}
+
+	cmd.AddCommand(
+		signerStart(),
+	)
+
+	flags.SetPersistentFlagHomeDir(cmd)
+
+	return cmd
+}
+
+// signerStart serves signing requests from remote keyrings using the local keyring.
+func signerStart() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Serve signing requests from remote keyrings using the local keyring",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			listenAddr, err := flags.GetSignerListenAddr(cmd)
+			if err != nil {
+				return err
+			}
+			if listenAddr == "" {
+				homeDir, err := client.HomeDirFromCmd(cmd)
+				if err != nil {
+					return err
+				}
+
+				listenAddr = signer.DefaultAddr(homeDir)
+			}
+
+			tokenFile, err := flags.GetSignerTokenFile(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client and open the local keyring
+			c := client.NewDefault()
+
+			kr, err := c.Keyring(opts)
+			if err != nil {
+				return err
+			}
+
+			server, err := signer.NewServer(kr, c)
+			if err != nil {
+				return err
+			}
+			if tokenFile != "" {
+				token, err := signer.ReadTokenFile(tokenFile)
+				if err != nil {
+					return err
+				}
+
+				server.WithToken(token)
+			}
+
+			// Stop serving on interrupt or termination
+			ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+
+			cmd.Printf("Signer listening on %s\n", listenAddr)
+			return server.ListenAndServe(ctx, listenAddr)
+		},
+	}
+
+	flags.AddKeyringFlags(cmd)
+	flags.SetFlagSignerListenAddr(cmd)
+	flags.SetFlagSignerTokenFile(cmd)
+
+	return cmd
+}
@@ -0,0 +1,463 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/denoms"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// StakingCmd returns a new Cobra command for staking sub-commands.
+func StakingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking",
+		Short: "Sub-commands for querying validators and managing delegations.",
+	}
+
+	cmd.AddCommand(
+		stakingValidator(),
+		stakingValidators(),
+		stakingDelegation(),
+		stakingDelegations(),
+		stakingUnbondingDelegations(),
+		stakingRedelegations(),
+		stakingDelegate(),
+		stakingUndelegate(),
+		stakingRedelegate(),
+	)
+
+	flags.SetPersistentFlagHomeDir(cmd)
+	flags.SetPersistentFlagNetwork(cmd)
+
+	return cmd
+}
+
+// bondStatusFromString parses a bond status such as "bonded" into a stakingtypes.BondStatus.
+// An empty string returns the unspecified status.
+func bondStatusFromString(v string) (stakingtypes.BondStatus, error) {
+	if v == "" {
+		return stakingtypes.Unspecified, nil
+	}
+
+	status, ok := stakingtypes.BondStatus_value["BOND_STATUS_"+strings.ToUpper(v)]
+	if !ok {
+		return stakingtypes.Unspecified, fmt.Errorf("invalid bond status %s", v)
+	}
+
+	return stakingtypes.BondStatus(status), nil
+}
+
+// stakingValidator displays the details of the validator with the specified address.
+func stakingValidator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator [validator-addr]",
+		Short: "Show details of the validator with the specified address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := cosmossdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the validator details
+			validator, err := c.Validator(cmd.Context(), valAddr, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the validator details
+			return writeOutputToCmd(cmd, validator, outputFormat)
+		},
+	}
+
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// stakingValidators lists the validators, optionally filtered by bond status.
+func stakingValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators",
+		Short: "List validators, optionally filtered by bond status",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := flags.GetStakingStatus(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := bondStatusFromString(statusStr)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of validators
+			validators, err := c.Validators(cmd.Context(), status, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the validator list
+			return writeOutputToCmd(cmd, validators, outputFormat)
+		},
+	}
+
+	flags.AddPageFlags(cmd)
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+	flags.SetFlagStakingStatus(cmd)
+
+	return cmd
+}
+
+// stakingDelegation displays the delegation of an account to a validator.
+func stakingDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation [acc-addr] [validator-addr]",
+		Short: "Show the delegation of an account to a validator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			accAddr, err := cosmossdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := cosmossdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the delegation
+			delegation, err := c.Delegation(cmd.Context(), accAddr, valAddr, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the delegation
+			return writeOutputToCmd(cmd, delegation, outputFormat)
+		},
+	}
+
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// stakingDelegations lists the delegations of an account.
+func stakingDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations [acc-addr]",
+		Short: "List the delegations of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			accAddr, err := cosmossdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of delegations
+			delegations, err := c.DelegationsForAccount(cmd.Context(), accAddr, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the delegation list
+			return writeOutputToCmd(cmd, delegations, outputFormat)
+		},
+	}
+
+	flags.AddPageFlags(cmd)
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// stakingUnbondingDelegations lists the unbonding delegations of an account.
+func stakingUnbondingDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-delegations [acc-addr]",
+		Short: "List the unbonding delegations of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			accAddr, err := cosmossdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of unbonding delegations
+			items, err := c.UnbondingDelegationsForAccount(cmd.Context(), accAddr, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the unbonding delegation list
+			return writeOutputToCmd(cmd, items, outputFormat)
+		},
+	}
+
+	flags.AddPageFlags(cmd)
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// stakingRedelegations lists the redelegations of an account.
+func stakingRedelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegations [acc-addr]",
+		Short: "List the redelegations of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			accAddr, err := cosmossdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Fetch the list of redelegations
+			items, err := c.RedelegationsForAccount(cmd.Context(), accAddr, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the redelegation list
+			return writeOutputToCmd(cmd, items, outputFormat)
+		},
+	}
+
+	flags.AddPageFlags(cmd)
+	flags.AddQueryFlags(cmd)
+	flags.AddOutputFlags(cmd)
+
+	return cmd
+}
+
+// stakingDelegate delegates an amount from the signing key to a validator.
+func stakingDelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate [validator-addr] [amount]",
+		Short: "Delegate an amount from the signing key to a validator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := cosmossdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := denoms.DefaultRegistry.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Broadcast the delegation
+			res, err := c.Delegate(cmd.Context(), valAddr, amount, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the broadcast result
+			return writeOutputToCmd(cmd, res, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
+
+// stakingUndelegate undelegates an amount of the signing key from a validator.
+func stakingUndelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate [validator-addr] [amount]",
+		Short: "Undelegate an amount of the signing key from a validator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := cosmossdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := denoms.DefaultRegistry.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Broadcast the undelegation
+			res, err := c.Undelegate(cmd.Context(), valAddr, amount, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the broadcast result
+			return writeOutputToCmd(cmd, res, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
+
+// stakingRedelegate moves an amount of the signing key from one validator to another.
+func stakingRedelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegate [src-validator-addr] [dst-validator-addr] [amount]",
+		Short: "Move an amount of the signing key from one validator to another",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			srcValAddr, err := cosmossdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			dstValAddr, err := cosmossdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := denoms.DefaultRegistry.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			// Broadcast the redelegation
+			res, err := c.Redelegate(cmd.Context(), srcValAddr, dstValAddr, amount, opts)
+			if err != nil {
+				return err
+			}
+
+			// Output the broadcast result
+			return writeOutputToCmd(cmd, res, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,827 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	base "github.com/sentinel-official/hub/v12/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	leasetypes "github.com/sentinel-official/hub/v12/x/lease/types/v1"
+	v3nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v3"
+	v3plantypes "github.com/sentinel-official/hub/v12/x/plan/types/v3"
+	v3sessiontypes "github.com/sentinel-official/hub/v12/x/session/types/v3"
+	v3subscriptiontypes "github.com/sentinel-official/hub/v12/x/subscription/types/v3"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/denoms"
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// TxCmd returns a new Cobra command for broadcasting hub module transactions.
+func TxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx",
+		Short: "Sub-commands for broadcasting session, subscription, node, plan and lease transactions.",
+	}
+
+	cmd.AddCommand(
+		txStartSession(),
+		txStartPlanSession(),
+		txStartSubscriptionSession(),
+		txEndSession(),
+		txSubscribe(),
+		txCancelSubscription(),
+		txRegisterNode(),
+		txUpdateNodeDetails(),
+		txUpdateNodeStatus(),
+		txCreatePlan(),
+		txLinkNode(),
+		txUnlinkNode(),
+		txUpdatePlanStatus(),
+		txStartLease(),
+		txEndLease(),
+	)
+
+	flags.SetPersistentFlagHomeDir(cmd)
+	flags.SetPersistentFlagNetwork(cmd)
+
+	return cmd
+}
+
+// txOutput is the result of a transaction with its decoded message responses.
+type txOutput struct {
+	Hash         string          `json:"hash" yaml:"hash"`
+	Height       int64           `json:"height,omitempty" yaml:"height,omitempty"`
+	Code         uint32          `json:"code" yaml:"code"`
+	Codespace    string          `json:"codespace,omitempty" yaml:"codespace,omitempty"`
+	Log          string          `json:"log,omitempty" yaml:"log,omitempty"`
+	GasUsed      int64           `json:"gas_used,omitempty" yaml:"gas_used,omitempty"`
+	MsgResponses []proto.Message `json:"msg_responses,omitempty" yaml:"msg_responses,omitempty"`
+}
+
+// broadcastTxToCmd broadcasts the message, waits for the transaction to be included in a block, and writes
+// the result, with its decoded message responses, to the command's output. A transaction rejected before
+// inclusion is written with the result of the broadcast.
+func broadcastTxToCmd(cmd *cobra.Command, c *client.Client, msg cosmossdk.Msg, opts *client.Options, format string) error {
+	res, err := c.BroadcastTx(cmd.Context(), []cosmossdk.Msg{msg}, opts)
+	if err != nil {
+		return err
+	}
+
+	output := &txOutput{
+		Hash:      res.Hash.String(),
+		Code:      res.Code,
+		Codespace: res.Codespace,
+		Log:       res.Log,
+	}
+	if res.Code != 0 {
+		return writeOutputToCmd(cmd, output, format)
+	}
+
+	// Wait for the transaction to be executed, as the broadcast result holds no message responses
+	tx, err := c.WaitForTx(cmd.Context(), res.Hash, opts)
+	if err != nil {
+		return err
+	}
+
+	output.Height = tx.Height
+	output.Code = tx.TxResult.Code
+	output.Codespace = tx.TxResult.Codespace
+	output.Log = tx.TxResult.Log
+	output.GasUsed = tx.TxResult.GasUsed
+
+	// Decode the message responses of a successful transaction
+	if tx.TxResult.Code == 0 {
+		output.MsgResponses, err = c.TxMsgResponses(tx.TxResult.Data)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Output the transaction result
+	return writeOutputToCmd(cmd, output, format)
+}
+
+// flagCoinsFromCmd parses the comma-separated coins of a string flag, returning nil if the flag is empty.
+func flagCoinsFromCmd(cmd *cobra.Command, get func(*cobra.Command) (string, error)) (cosmossdk.Coins, error) {
+	v, err := get(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if v == "" {
+		return nil, nil
+	}
+
+	return denoms.DefaultRegistry.ParseCoins(v)
+}
+
+// txStartSession starts a session on a node, paying for the requested gigabytes or hours in the specified denom.
+func txStartSession() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "start-session [node-addr] [denom]",
+		Short:             "Start a session on a node, paying for gigabytes or hours in the specified denom",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeArgs(completeNodeAddrs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			nodeAddr, err := base.NodeAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			gigabytes, err := flags.GetHubGigabytes(cmd)
+			if err != nil {
+				return err
+			}
+
+			hours, err := flags.GetHubHours(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the session start message
+			msg := v3nodetypes.NewMsgStartSessionRequest(accAddr, nodeAddr, gigabytes, hours, args[1])
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+	flags.SetFlagHubGigabytes(cmd)
+	flags.SetFlagHubHours(cmd)
+
+	return cmd
+}
+
+// txStartPlanSession subscribes to a plan and starts a session on one of its nodes.
+func txStartPlanSession() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "start-plan-session [plan-id] [node-addr] [denom]",
+		Short:             "Subscribe to a plan and start a session on one of its nodes",
+		Args:              cobra.ExactArgs(3),
+		ValidArgsFunction: completeArgs(completePlanIDs, completeNodeAddrs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			nodeAddr, err := base.NodeAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			renewable, err := flags.GetHubRenewable(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the session start message
+			msg := v3plantypes.NewMsgStartSessionRequest(accAddr, id, args[2], renewable, nodeAddr)
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+	flags.SetFlagHubRenewable(cmd)
+
+	return cmd
+}
+
+// txStartSubscriptionSession starts a session on a node using an existing subscription.
+func txStartSubscriptionSession() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "start-subscription-session [subscription-id] [node-addr]",
+		Short:             "Start a session on a node using an existing subscription",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeArgs(nil, completeNodeAddrs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			nodeAddr, err := base.NodeAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the session start message
+			msg := v3subscriptiontypes.NewMsgStartSessionRequest(accAddr, id, nodeAddr)
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
+
+// txEndSession ends the session with the specified ID.
+func txEndSession() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "end-session [id]",
+		Short: "End the session with the specified ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the session end message
+			msg := v3sessiontypes.NewMsgCancelSessionRequest(accAddr, id)
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
+
+// txSubscribe subscribes to a plan, paying in the specified denom.
+func txSubscribe() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "subscribe [plan-id] [denom]",
+		Short:             "Subscribe to a plan, paying in the specified denom",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeArgs(completePlanIDs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			renewable, err := flags.GetHubRenewable(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the subscription message
+			msg := v3subscriptiontypes.NewMsgStartSubscriptionRequest(accAddr, id, args[1], renewable)
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+	flags.SetFlagHubRenewable(cmd)
+
+	return cmd
+}
+
+// txCancelSubscription cancels the subscription with the specified ID.
+func txCancelSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-subscription [id]",
+		Short: "Cancel the subscription with the specified ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the subscription cancellation message
+			msg := v3subscriptiontypes.NewMsgCancelSubscriptionRequest(accAddr, id)
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
+
+// txRegisterNode registers the signing key as a node reachable at the specified URL.
+func txRegisterNode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-node [remote-url]",
+		Short: "Register the signing key as a node reachable at the specified URL",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			gigabytePrices, err := flagCoinsFromCmd(cmd, flags.GetHubGigabytePrices)
+			if err != nil {
+				return err
+			}
+
+			hourlyPrices, err := flagCoinsFromCmd(cmd, flags.GetHubHourlyPrices)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the node registration message
+			msg := v3nodetypes.NewMsgRegisterNodeRequest(accAddr, gigabytePrices, hourlyPrices, args[0])
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+	flags.SetFlagHubGigabytePrices(cmd)
+	flags.SetFlagHubHourlyPrices(cmd)
+
+	return cmd
+}
+
+// txUpdateNodeDetails updates the prices and URL of the node of the signing key.
+func txUpdateNodeDetails() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-node-details [remote-url]",
+		Short: "Update the prices and URL of the node of the signing key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			gigabytePrices, err := flagCoinsFromCmd(cmd, flags.GetHubGigabytePrices)
+			if err != nil {
+				return err
+			}
+
+			hourlyPrices, err := flagCoinsFromCmd(cmd, flags.GetHubHourlyPrices)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the node details update message
+			msg := v3nodetypes.NewMsgUpdateNodeDetailsRequest(base.NodeAddress(accAddr.Bytes()), gigabytePrices, hourlyPrices, args[0])
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+	flags.SetFlagHubGigabytePrices(cmd)
+	flags.SetFlagHubHourlyPrices(cmd)
+
+	return cmd
+}
+
+// txUpdateNodeStatus updates the status of the node of the signing key.
+func txUpdateNodeStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-node-status [status]",
+		Short: "Update the status of the node of the signing key (active or inactive)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			status := v1base.StatusFromString(args[0])
+			if !status.IsValid() {
+				return fmt.Errorf("invalid status %s", args[0])
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the node status update message
+			msg := v3nodetypes.NewMsgUpdateNodeStatusRequest(base.NodeAddress(accAddr.Bytes()), status)
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
+
+// txCreatePlan creates a plan of the provider of the signing key with the specified duration, gigabytes and prices.
+func txCreatePlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-plan [duration] [gigabytes] [prices]",
+		Short: "Create a plan of the provider of the signing key",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[0])
+			if err != nil {
+				return err
+			}
+
+			gigabytes, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			prices, err := denoms.DefaultRegistry.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the plan creation message
+			msg := v3plantypes.NewMsgCreatePlanRequest(base.ProvAddress(accAddr.Bytes()), duration, gigabytes, prices)
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
+
+// txLinkNode links a node to a plan of the provider of the signing key.
+func txLinkNode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "link-node [plan-id] [node-addr]",
+		Short:             "Link a node to a plan of the provider of the signing key",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeArgs(completePlanIDs, completeNodeAddrs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			nodeAddr, err := base.NodeAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the node link message
+			msg := v3plantypes.NewMsgLinkNodeRequest(base.ProvAddress(accAddr.Bytes()), id, nodeAddr)
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
+
+// txUnlinkNode unlinks a node from a plan of the provider of the signing key.
+func txUnlinkNode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "unlink-node [plan-id] [node-addr]",
+		Short:             "Unlink a node from a plan of the provider of the signing key",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeArgs(completePlanIDs, completeNodeAddrs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			nodeAddr, err := base.NodeAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the node unlink message
+			msg := v3plantypes.NewMsgUnlinkNodeRequest(base.ProvAddress(accAddr.Bytes()), id, nodeAddr)
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
+
+// txUpdatePlanStatus updates the status of a plan of the provider of the signing key.
+func txUpdatePlanStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "update-plan-status [plan-id] [status]",
+		Short:             "Update the status of a plan of the provider of the signing key",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeArgs(completePlanIDs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			status := v1base.StatusFromString(args[1])
+			if !status.IsValid() {
+				return fmt.Errorf("invalid status %s", args[1])
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the plan status update message
+			msg := v3plantypes.NewMsgUpdatePlanStatusRequest(base.ProvAddress(accAddr.Bytes()), id, status)
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
+
+// txStartLease leases a node for the provider of the signing key for the specified hours, paying in the specified denom.
+func txStartLease() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "start-lease [node-addr] [hours] [denom]",
+		Short:             "Lease a node for the provider of the signing key",
+		Args:              cobra.ExactArgs(3),
+		ValidArgsFunction: completeArgs(completeNodeAddrs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			nodeAddr, err := base.NodeAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			hours, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			renewable, err := flags.GetHubRenewable(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the lease start message
+			msg := leasetypes.NewMsgStartLeaseRequest(base.ProvAddress(accAddr.Bytes()), nodeAddr, hours, args[2], renewable)
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+	flags.SetFlagHubRenewable(cmd)
+
+	return cmd
+}
+
+// txEndLease ends the lease with the specified ID.
+func txEndLease() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "end-lease [id]",
+		Short: "End the lease with the specified ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := client.NewFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+
+			outputFormat, err := flags.GetOutputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Initialize the Client
+			c := client.NewDefault()
+
+			accAddr, err := c.FromAddr(opts)
+			if err != nil {
+				return err
+			}
+
+			// Build and broadcast the lease end message
+			msg := leasetypes.NewMsgEndLeaseRequest(base.ProvAddress(accAddr.Bytes()), id)
+			return broadcastTxToCmd(cmd, c, msg, opts, outputFormat)
+		},
+	}
+
+	addTxCmdFlags(cmd)
+
+	return cmd
+}
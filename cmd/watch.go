@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/sentinel-go-sdk/flags"
+)
+
+// Constants for the watch mode.
+const (
+	clearScreen  = "\033[H\033[2J" // clearScreen is the ANSI sequence moving the cursor home and clearing the terminal.
+	maxDiffLines = 1000            // maxDiffLines bounds the changed lines compared between runs; larger changes are printed in full.
+)
+
+// addWatchToCmds wraps the RunE function of each command so it runs in watch mode when the watch flag is set.
+func addWatchToCmds(cmds ...*cobra.Command) {
+	for _, cmd := range cmds {
+		if cmd.RunE == nil {
+			continue
+		}
+
+		runE := cmd.RunE
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			watch, err := flags.GetWatch(cmd)
+			if err != nil {
+				return err
+			}
+			if !watch {
+				return runE(cmd, args)
+			}
+
+			return runWatch(cmd, args, runE)
+		}
+	}
+}
+
+// isTerminal reports whether the writer is a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && (isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd()))
+}
+
+// runWatch runs the command at the watch interval until it is interrupted. A table written to a terminal
+// is refreshed in place; any other output is printed once, followed by the lines that changed at each run,
+// or by the whole output when too many lines changed to compare them.
+// A failure of the first run is returned, while later failures are reported and the command is run again.
+func runWatch(cmd *cobra.Command, args []string, runE func(*cobra.Command, []string) error) error {
+	s, err := flags.GetWatchInterval(cmd)
+	if err != nil {
+		return err
+	}
+
+	interval, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("invalid watch interval %s: %w", s, err)
+	}
+	if interval <= 0 {
+		return errors.New("watch interval must be positive")
+	}
+
+	outputFormat, err := flags.GetOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Stop watching on interrupt or termination
+	ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	w := cmd.OutOrStderr()
+	inPlace := outputFormat == flags.OutputFormatTable && isTerminal(w)
+
+	cmd.SetContext(ctx)
+	defer cmd.SetOut(w)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var prev []string
+	for i := 0; ; i++ {
+		// Capture the output of the run, to compare it with the previous one
+		var buf bytes.Buffer
+		cmd.SetOut(&buf)
+		err := runE(cmd, args)
+		cmd.SetOut(w)
+
+		now := time.Now().Format(time.TimeOnly)
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case err != nil && i == 0:
+			return err
+		case err != nil:
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s error: %s\n", now, err)
+		case inPlace:
+			_, _ = fmt.Fprintf(w, "%sEvery %s: %s\n\n%s", clearScreen, interval, now, buf.String())
+		case i == 0:
+			_, _ = fmt.Fprint(w, buf.String())
+		default:
+			lines, ok := diffLines(prev, outputLines(buf.String()))
+			if !ok {
+				_, _ = fmt.Fprintf(w, "--- %s\n%s", now, buf.String())
+			} else if len(lines) > 0 {
+				_, _ = fmt.Fprintf(w, "--- %s\n%s\n", now, strings.Join(lines, "\n"))
+			}
+		}
+		if err == nil {
+			prev = outputLines(buf.String())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// outputLines splits the output into lines, ignoring the trailing newlines.
+func outputLines(s string) []string {
+	s = strings.TrimRight(s, "\n")
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, "\n")
+}
+
+// diffLines returns the lines removed from a, prefixed with "- ", and the lines added in b, prefixed with "+ ",
+// in the order they appear. The unchanged lines are the common prefix and suffix of a and b, and the longest
+// common subsequence of the lines between them. It returns false if more than maxDiffLines lines lie between
+// the common prefix and suffix, as comparing them would take quadratic time and memory.
+func diffLines(a, b []string) ([]string, bool) {
+	// Skip the lines common to the start and the end of both outputs.
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+	if len(a) > maxDiffLines || len(b) > maxDiffLines {
+		return nil, false
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var res []string
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i, j = i+1, j+1
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			res = append(res, "- "+a[i])
+			i++
+		default:
+			res = append(res, "+ "+b[j])
+			j++
+		}
+	}
+
+	return res, true
+}
@@ -0,0 +1,165 @@
+package connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	base "github.com/sentinel-official/hub/v12/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v2"
+	v3nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v3"
+	sessiontypes "github.com/sentinel-official/hub/v12/x/session/types/v3"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+)
+
+// Ensure HubChain implements the Chain interface.
+var _ Chain = (*HubChain)(nil)
+
+// HubChain is a Chain backed by the hub, signing transactions with the key in the options.
+type HubChain struct {
+	c    *client.Client  // Client used to query the hub and broadcast transactions.
+	opts *client.Options // Options used for queries and transactions.
+}
+
+// NewHubChain creates a new HubChain using the given client and options.
+func NewHubChain(c *client.Client, opts *client.Options) *HubChain {
+	return &HubChain{
+		c:    c,
+		opts: opts,
+	}
+}
+
+// errTxFailed is returned when a transaction is included in a block but fails to execute.
+var errTxFailed = errors.New("transaction failed")
+
+// broadcastTx broadcasts the message and returns the hash of the transaction.
+func (h *HubChain) broadcastTx(ctx context.Context, msg sdk.Msg) (bytes.HexBytes, error) {
+	res, err := h.c.BroadcastTx(ctx, []sdk.Msg{msg}, h.opts)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, fmt.Errorf("transaction %s failed with code %d: %s", res.Hash, res.Code, res.Log)
+	}
+
+	return res.Hash, nil
+}
+
+// waitForTx waits for the transaction to be included in a block and returns its message responses.
+func (h *HubChain) waitForTx(ctx context.Context, hash bytes.HexBytes) ([]proto.Message, error) {
+	tx, err := h.c.WaitForTx(ctx, hash, h.opts)
+	if err != nil {
+		return nil, err
+	}
+	if tx.TxResult.Code != 0 {
+		return nil, fmt.Errorf("%w: %s with code %d: %s", errTxFailed, hash, tx.TxResult.Code, tx.TxResult.Log)
+	}
+
+	return h.c.TxMsgResponses(tx.TxResult.Data)
+}
+
+// Node returns the node with the given address.
+func (h *HubChain) Node(ctx context.Context, nodeAddr base.NodeAddress) (*nodetypes.Node, error) {
+	node, err := h.c.Node(ctx, nodeAddr, h.opts)
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, fmt.Errorf("node %s does not exist", nodeAddr)
+	}
+
+	return node, nil
+}
+
+// StartSession starts a session on the node and returns its ID. Once the transaction is broadcast, its
+// hash is returned as well, also when the session cannot be resolved, so the session can be ended later.
+func (h *HubChain) StartSession(ctx context.Context, nodeAddr base.NodeAddress, gigabytes, hours int64, denom string) (uint64, []byte, error) {
+	accAddr, err := h.c.FromAddr(h.opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	msg := v3nodetypes.NewMsgStartSessionRequest(accAddr, nodeAddr, gigabytes, hours, denom)
+
+	hash, err := h.broadcastTx(ctx, msg)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	id, err := h.SessionFromTx(ctx, nodeAddr, hash)
+	if errors.Is(err, ErrSessionNotStarted) {
+		return 0, nil, err
+	}
+	if err != nil {
+		return 0, hash, err
+	}
+
+	return id, hash, nil
+}
+
+// SessionFromTx returns the ID of the session started on the node by the transaction with the given hash.
+// It fails with ErrSessionNotStarted if the transaction failed to execute. If the ID is missing from the
+// message responses, the latest active session of the account on the node is returned.
+func (h *HubChain) SessionFromTx(ctx context.Context, nodeAddr base.NodeAddress, hash []byte) (uint64, error) {
+	res, err := h.waitForTx(ctx, hash)
+	if errors.Is(err, errTxFailed) {
+		return 0, fmt.Errorf("%w: %w", ErrSessionNotStarted, err)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	// Find the ID of the session in the message responses.
+	for _, item := range res {
+		if v, ok := item.(*v3nodetypes.MsgStartSessionResponse); ok {
+			return v.ID, nil
+		}
+	}
+
+	accAddr, err := h.c.FromAddr(h.opts)
+	if err != nil {
+		return 0, err
+	}
+
+	sessions, err := client.QueryAll(h.opts, func(opts *client.Options) ([]sessiontypes.Session, error) {
+		return h.c.SessionsForAccount(ctx, accAddr, opts)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var id uint64
+	for _, item := range sessions {
+		if item.GetNodeAddress() == nodeAddr.String() && item.GetStatus().Equal(v1base.StatusActive) {
+			id = max(id, item.GetID())
+		}
+	}
+	if id == 0 {
+		return 0, fmt.Errorf("session of transaction %X not found", hash)
+	}
+
+	return id, nil
+}
+
+// EndSession ends the session with the given ID.
+func (h *HubChain) EndSession(ctx context.Context, id uint64) error {
+	accAddr, err := h.c.FromAddr(h.opts)
+	if err != nil {
+		return err
+	}
+
+	msg := sessiontypes.NewMsgCancelSessionRequest(accAddr, id)
+
+	hash, err := h.broadcastTx(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.waitForTx(ctx, hash)
+	return err
+}
@@ -0,0 +1,341 @@
+package connector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	base "github.com/sentinel-official/hub/v12/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v2"
+
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+	"github.com/sentinel-official/sentinel-go-sdk/utils"
+)
+
+// Constants for the connection.
+const (
+	connectionFileName = "connection.json" // connectionFileName is the name of the file in the home directory holding the active connection.
+	rollbackTimeout    = 2 * time.Minute   // rollbackTimeout bounds the time spent ending the session of a failed connection.
+)
+
+// ErrSessionNotStarted is returned when the transaction starting a session failed to execute.
+var ErrSessionNotStarted = errors.New("session not started")
+
+// Chain is the part of the hub used to connect to a node. It can be replaced by a fake chain for testing.
+type Chain interface {
+	Node(ctx context.Context, nodeAddr base.NodeAddress) (*nodetypes.Node, error)                                              // Node returns the node with the given address.
+	StartSession(ctx context.Context, nodeAddr base.NodeAddress, gigabytes, hours int64, denom string) (uint64, []byte, error) // StartSession starts a session on the node and returns its ID and the hash of its transaction.
+	SessionFromTx(ctx context.Context, nodeAddr base.NodeAddress, hash []byte) (uint64, error)                                 // SessionFromTx returns the ID of the session started by the transaction.
+	EndSession(ctx context.Context, id uint64) error                                                                           // EndSession ends the session with the given ID.
+}
+
+// Node is the API of a node used to connect to it. It can be replaced by a fake node for testing.
+type Node interface {
+	Info(ctx context.Context, remoteURL string) (*types.NodeInfo, error)                                     // Info returns the information of the node.
+	Handshake(ctx context.Context, remoteURL string, id uint64, data []byte) (*types.HandshakeResult, error) // Handshake adds the client as a peer of the session.
+}
+
+// Service prepares the client service of a VPN protocol for a connection.
+type Service interface {
+	Client() types.ClientService                                   // Client returns the client service.
+	PeerRequest() ([]byte, error)                                  // PeerRequest returns the data sent to the node in the handshake.
+	ClientOptions(res *types.HandshakeResult) (interface{}, error) // ClientOptions returns the options passed to PreUp of the client service.
+}
+
+// ServiceFunc returns the Service for the given service type.
+type ServiceFunc func(t types.ServiceType) (Service, error)
+
+// Connection represents an active connection to a node.
+type Connection struct {
+	ID          uint64    `json:"id"`           // ID is the identifier of the session.
+	NodeAddress string    `json:"node_address"` // NodeAddress is the address of the node.
+	RemoteURL   string    `json:"remote_url"`   // RemoteURL is the URL of the node API.
+	ServiceType string    `json:"service_type"` // ServiceType is the type of the service of the node.
+	ConnectedAt time.Time `json:"connected_at"` // ConnectedAt is the time the connection was established.
+}
+
+// Status represents the state of the connection.
+type Status struct {
+	Connected     bool        `json:"connected"`            // Connected indicates whether a connection is active.
+	Connection    *Connection `json:"connection,omitempty"` // Connection is the active connection, if any.
+	Up            bool        `json:"up"`                   // Up indicates whether the client service is up.
+	DownloadBytes int64       `json:"download_bytes"`       // DownloadBytes is the number of bytes downloaded.
+	UploadBytes   int64       `json:"upload_bytes"`         // UploadBytes is the number of bytes uploaded.
+}
+
+// Connector runs the flow of connecting to and disconnecting from a node: starting a session on the
+// chain, performing the handshake with the node, and bringing the client service up or down.
+type Connector struct {
+	chain    Chain       // Chain used to query nodes and start or end sessions.
+	homeDir  string      // Home directory holding the connection file.
+	node     Node        // Node API used for the handshake.
+	services ServiceFunc // Function returning the service for the type of a node.
+}
+
+// NewConnector creates a new Connector with the given home directory, chain, node API and services.
+func NewConnector(homeDir string, chain Chain, node Node, services ServiceFunc) *Connector {
+	return &Connector{
+		chain:    chain,
+		homeDir:  homeDir,
+		node:     node,
+		services: services,
+	}
+}
+
+// connectionFilePath returns the file path of the connection file.
+func (c *Connector) connectionFilePath() string {
+	return filepath.Join(c.homeDir, connectionFileName)
+}
+
+// readConnection reads the active connection from the connection file.
+// It returns nil if no connection is active.
+func (c *Connector) readConnection() (*Connection, error) {
+	buf, err := os.ReadFile(c.connectionFilePath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var v Connection
+	if err := json.Unmarshal(buf, &v); err != nil {
+		return nil, fmt.Errorf("failed to decode connection file: %w", err)
+	}
+
+	return &v, nil
+}
+
+// writeConnection writes the active connection to the connection file.
+func (c *Connector) writeConnection(v *Connection) error {
+	buf, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.homeDir, 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.connectionFilePath(), buf, 0600)
+}
+
+// Connect connects to the node with the given address. It starts a session paying for the requested
+// gigabytes or hours in the given denom, performs the handshake with the node, and brings the client
+// service up. If any step fails, the steps already completed are rolled back.
+func (c *Connector) Connect(ctx context.Context, nodeAddr base.NodeAddress, gigabytes, hours int64, denom string) (res *Connection, err error) {
+	// Only one connection can be active at a time.
+	conn, err := c.readConnection()
+	if err != nil {
+		return nil, err
+	}
+	if conn != nil {
+		return nil, fmt.Errorf("already connected to node %s with session %d", conn.NodeAddress, conn.ID)
+	}
+
+	// Undo the completed steps, in reverse order, if a later step fails.
+	var rollbacks []func() error
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		for i := len(rollbacks) - 1; i >= 0; i-- {
+			if rerr := rollbacks[i](); rerr != nil {
+				err = errors.Join(err, fmt.Errorf("rollback failed: %w", rerr))
+			}
+		}
+	}()
+
+	// Query the node and check that it is active.
+	node, err := c.chain.Node(ctx, nodeAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !node.Status.Equal(v1base.StatusActive) {
+		return nil, fmt.Errorf("node %s is not active", nodeAddr)
+	}
+
+	// Query the node API and check that it belongs to the node.
+	info, err := c.node.Info(ctx, node.RemoteURL)
+	if err != nil {
+		return nil, err
+	}
+	if !info.Address.Equals(nodeAddr) {
+		return nil, fmt.Errorf("node at %s has address %s, expected %s", node.RemoteURL, info.Address, nodeAddr)
+	}
+
+	// Prepare the client service for the type of the node.
+	svc, err := c.services(info.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := svc.PeerRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	// Start the session on the chain. Once its transaction is broadcast, the session is ended on failure,
+	// even if its ID could not be resolved yet.
+	id, txHash, err := c.chain.StartSession(ctx, nodeAddr, gigabytes, hours, denom)
+	if txHash != nil {
+		rollbacks = append(rollbacks, func() error {
+			return c.endSession(nodeAddr, id, txHash)
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the client as a peer of the session on the node.
+	result, err := c.node.Handshake(ctx, node.RemoteURL, id, data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := svc.ClientOptions(result)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bring the client service up.
+	client := svc.Client()
+	rollbacks = append(rollbacks, client.PostDown)
+	if err := client.PreUp(cfg); err != nil {
+		return nil, err
+	}
+	if err := client.Up(ctx); err != nil {
+		return nil, err
+	}
+
+	rollbacks = append(rollbacks, func() error {
+		return client.Down(context.Background())
+	})
+	if err := client.PostUp(); err != nil {
+		return nil, err
+	}
+
+	// Record the connection, so it can be inspected and ended later.
+	res = &Connection{
+		ID:          id,
+		NodeAddress: nodeAddr.String(),
+		RemoteURL:   node.RemoteURL,
+		ServiceType: info.Type.String(),
+		ConnectedAt: time.Now().UTC(),
+	}
+
+	if err := c.writeConnection(res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// endSession ends the session of a failed connection. A session without an ID is first resolved from the
+// hash of the transaction that started it; nothing is ended if that transaction failed.
+func (c *Connector) endSession(nodeAddr base.NodeAddress, id uint64, txHash []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rollbackTimeout)
+	defer cancel()
+
+	if id == 0 {
+		v, err := c.chain.SessionFromTx(ctx, nodeAddr, txHash)
+		if errors.Is(err, ErrSessionNotStarted) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to resolve the session of transaction %X: %w", txHash, err)
+		}
+
+		id = v
+	}
+
+	if err := c.chain.EndSession(ctx, id); err != nil {
+		return fmt.Errorf("failed to end session %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// Disconnect brings the client service of the active connection down and ends its session.
+// It returns the connection that was ended.
+func (c *Connector) Disconnect(ctx context.Context) (*Connection, error) {
+	conn, err := c.readConnection()
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, errors.New("not connected")
+	}
+
+	svc, err := c.services(types.ServiceTypeFromString(conn.ServiceType))
+	if err != nil {
+		return nil, err
+	}
+
+	// Bring the client service down if it is still up.
+	client := svc.Client()
+	if up, _ := client.IsUp(ctx); up {
+		if err := client.PreDown(); err != nil {
+			return nil, err
+		}
+		if err := client.Down(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if err := client.PostDown(); err != nil {
+		return nil, err
+	}
+
+	// End the session on the chain.
+	if err := c.chain.EndSession(ctx, conn.ID); err != nil {
+		return nil, err
+	}
+
+	if err := utils.RemoveFile(c.connectionFilePath()); err != nil {
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// Status returns the state of the active connection and the statistics of its client service.
+func (c *Connector) Status(ctx context.Context) (*Status, error) {
+	conn, err := c.readConnection()
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return &Status{}, nil
+	}
+
+	svc, err := c.services(types.ServiceTypeFromString(conn.ServiceType))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Status{
+		Connected:  true,
+		Connection: conn,
+	}
+
+	// A client service that cannot be inspected is reported as down.
+	client := svc.Client()
+	if up, _ := client.IsUp(ctx); !up {
+		return res, nil
+	}
+
+	res.Up = true
+	res.DownloadBytes, res.UploadBytes, err = client.Statistics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,376 @@
+package connector
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	base "github.com/sentinel-official/hub/v12/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v2"
+
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+	"github.com/sentinel-official/sentinel-go-sdk/v2ray"
+)
+
+// testNodeAddr is the address of the node the tests connect to.
+var testNodeAddr = base.NodeAddress([]byte("test-node-address-00"))
+
+// testCalls records the calls made to the fakes, in order, and fails the calls listed in errs.
+type testCalls struct {
+	calls []string
+	errs  map[string]error
+	up    bool
+}
+
+// call records the named call and returns its error, if any.
+func (t *testCalls) call(name string) error {
+	t.calls = append(t.calls, name)
+	return t.errs[name]
+}
+
+// fakeChain is a Chain recording its calls.
+type fakeChain struct {
+	*testCalls
+	id     uint64
+	txHash []byte
+}
+
+func (c *fakeChain) Node(_ context.Context, nodeAddr base.NodeAddress) (*nodetypes.Node, error) {
+	return &nodetypes.Node{
+		Address:   nodeAddr.String(),
+		RemoteURL: "https://node.example:7777",
+		Status:    v1base.StatusActive,
+	}, nil
+}
+
+func (c *fakeChain) StartSession(_ context.Context, _ base.NodeAddress, _, _ int64, _ string) (uint64, []byte, error) {
+	if err := c.call("StartSession"); err != nil {
+		return 0, c.txHash, err
+	}
+
+	return c.id, c.txHash, nil
+}
+
+func (c *fakeChain) SessionFromTx(_ context.Context, _ base.NodeAddress, _ []byte) (uint64, error) {
+	if err := c.call("SessionFromTx"); err != nil {
+		return 0, err
+	}
+
+	return c.id, nil
+}
+
+func (c *fakeChain) EndSession(_ context.Context, id uint64) error {
+	if id != c.id {
+		return errors.New("unexpected session id")
+	}
+
+	return c.call("EndSession")
+}
+
+// fakeNode is a Node recording its calls.
+type fakeNode struct {
+	*testCalls
+}
+
+func (n *fakeNode) Info(_ context.Context, _ string) (*types.NodeInfo, error) {
+	return &types.NodeInfo{
+		Address: testNodeAddr,
+		Type:    types.ServiceTypeWireGuard,
+	}, nil
+}
+
+func (n *fakeNode) Handshake(_ context.Context, _ string, _ uint64, _ []byte) (*types.HandshakeResult, error) {
+	if err := n.call("Handshake"); err != nil {
+		return nil, err
+	}
+
+	return &types.HandshakeResult{}, nil
+}
+
+// fakeService is a Service and a ClientService recording their calls.
+type fakeService struct {
+	*testCalls
+}
+
+func (s *fakeService) Client() types.ClientService  { return s }
+func (s *fakeService) PeerRequest() ([]byte, error) { return []byte("peer"), s.call("PeerRequest") }
+func (s *fakeService) ClientOptions(_ *types.HandshakeResult) (interface{}, error) {
+	return struct{}{}, s.call("ClientOptions")
+}
+
+func (s *fakeService) Type() types.ServiceType              { return types.ServiceTypeWireGuard }
+func (s *fakeService) IsUp(_ context.Context) (bool, error) { return s.up, nil }
+func (s *fakeService) PreUp(_ interface{}) error            { return s.call("PreUp") }
+func (s *fakeService) PreDown() error                       { return s.call("PreDown") }
+func (s *fakeService) PostDown() error                      { return s.call("PostDown") }
+
+func (s *fakeService) Up(_ context.Context) error {
+	if err := s.call("Up"); err != nil {
+		return err
+	}
+
+	s.up = true
+	return nil
+}
+
+func (s *fakeService) PostUp() error {
+	return s.call("PostUp")
+}
+
+func (s *fakeService) Down(_ context.Context) error {
+	if err := s.call("Down"); err != nil {
+		return err
+	}
+
+	s.up = false
+	return nil
+}
+
+func (s *fakeService) Statistics(_ context.Context) (int64, int64, error) {
+	return 100, 50, nil
+}
+
+// newTestConnector creates a Connector in a temporary home directory, backed by fakes sharing the calls.
+func newTestConnector(t *testing.T, calls *testCalls, id uint64, txHash []byte) *Connector {
+	svc := &fakeService{calls}
+	services := func(v types.ServiceType) (Service, error) {
+		if v != types.ServiceTypeWireGuard {
+			return nil, errors.New("unsupported service type")
+		}
+
+		return svc, nil
+	}
+
+	return NewConnector(t.TempDir(), &fakeChain{calls, id, txHash}, &fakeNode{calls}, services)
+}
+
+func TestConnector_Connect(t *testing.T) {
+	errFailed := errors.New("failed")
+	txHash := []byte{0x01, 0x02}
+
+	tests := []struct {
+		name    string
+		txHash  []byte
+		errs    map[string]error
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "success",
+			txHash: txHash,
+			want:   []string{"PeerRequest", "StartSession", "Handshake", "ClientOptions", "PreUp", "Up", "PostUp"},
+		},
+		{
+			name:    "start session not broadcast",
+			errs:    map[string]error{"StartSession": errFailed},
+			want:    []string{"PeerRequest", "StartSession"},
+			wantErr: true,
+		},
+		{
+			name:    "start session not confirmed",
+			txHash:  txHash,
+			errs:    map[string]error{"StartSession": errFailed},
+			want:    []string{"PeerRequest", "StartSession", "SessionFromTx", "EndSession"},
+			wantErr: true,
+		},
+		{
+			name:    "start session failed to execute",
+			txHash:  txHash,
+			errs:    map[string]error{"StartSession": errFailed, "SessionFromTx": ErrSessionNotStarted},
+			want:    []string{"PeerRequest", "StartSession", "SessionFromTx"},
+			wantErr: true,
+		},
+		{
+			name:    "handshake failure",
+			txHash:  txHash,
+			errs:    map[string]error{"Handshake": errFailed},
+			want:    []string{"PeerRequest", "StartSession", "Handshake", "EndSession"},
+			wantErr: true,
+		},
+		{
+			name:    "up failure",
+			txHash:  txHash,
+			errs:    map[string]error{"Up": errFailed},
+			want:    []string{"PeerRequest", "StartSession", "Handshake", "ClientOptions", "PreUp", "Up", "PostDown", "EndSession"},
+			wantErr: true,
+		},
+		{
+			name:    "post up failure",
+			txHash:  txHash,
+			errs:    map[string]error{"PostUp": errFailed},
+			want:    []string{"PeerRequest", "StartSession", "Handshake", "ClientOptions", "PreUp", "Up", "PostUp", "Down", "PostDown", "EndSession"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := &testCalls{errs: tt.errs}
+			c := newTestConnector(t, calls, 1, tt.txHash)
+
+			res, err := c.Connect(context.Background(), testNodeAddr, 1, 0, "udvpn")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Connect() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(calls.calls, tt.want) {
+				t.Errorf("Connect() calls = %v, want %v", calls.calls, tt.want)
+			}
+
+			conn, err := c.readConnection()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantErr {
+				if conn != nil {
+					t.Errorf("connection = %+v, want none", conn)
+				}
+				return
+			}
+			if conn == nil || conn.ID != res.ID || conn.NodeAddress != testNodeAddr.String() {
+				t.Errorf("connection = %+v, want %+v", conn, res)
+			}
+		})
+	}
+}
+
+func TestConnector_Connect_rollbackFailure(t *testing.T) {
+	calls := &testCalls{errs: map[string]error{
+		"Handshake":  errors.New("handshake failed"),
+		"EndSession": errors.New("end session failed"),
+	}}
+	c := newTestConnector(t, calls, 1, []byte{0x01})
+
+	_, err := c.Connect(context.Background(), testNodeAddr, 1, 0, "udvpn")
+	if err == nil || !strings.Contains(err.Error(), "handshake failed") || !strings.Contains(err.Error(), "end session failed") {
+		t.Errorf("Connect() error = %v, want the handshake and rollback errors", err)
+	}
+}
+
+func TestConnector_Disconnect(t *testing.T) {
+	calls := &testCalls{}
+	c := newTestConnector(t, calls, 1, []byte{0x01})
+
+	if _, err := c.Disconnect(context.Background()); err == nil {
+		t.Fatal("Disconnect() without a connection succeeded")
+	}
+
+	if _, err := c.Connect(context.Background(), testNodeAddr, 1, 0, "udvpn"); err != nil {
+		t.Fatal(err)
+	}
+
+	calls.calls = nil
+	conn, err := c.Disconnect(context.Background())
+	if err != nil {
+		t.Fatalf("Disconnect() error = %v", err)
+	}
+	if conn.ID != 1 {
+		t.Errorf("Disconnect() ID = %d, want 1", conn.ID)
+	}
+
+	want := []string{"PreDown", "Down", "PostDown", "EndSession"}
+	if !reflect.DeepEqual(calls.calls, want) {
+		t.Errorf("Disconnect() calls = %v, want %v", calls.calls, want)
+	}
+
+	if v, err := c.readConnection(); err != nil || v != nil {
+		t.Errorf("connection = %+v, %v, want none", v, err)
+	}
+}
+
+func TestConnector_Status(t *testing.T) {
+	calls := &testCalls{}
+	c := newTestConnector(t, calls, 1, []byte{0x01})
+
+	res, err := c.Status(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Connected {
+		t.Errorf("Status() = %+v, want not connected", res)
+	}
+
+	if _, err := c.Connect(context.Background(), testNodeAddr, 1, 0, "udvpn"); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err = c.Status(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Connected || !res.Up || res.DownloadBytes != 100 || res.UploadBytes != 50 {
+		t.Errorf("Status() = %+v, want connected and up with statistics", res)
+	}
+
+	// A client service that went down is reported without statistics.
+	calls.up = false
+
+	res, err = c.Status(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Connected || res.Up || res.DownloadBytes != 0 {
+		t.Errorf("Status() = %+v, want connected and down", res)
+	}
+}
+
+func TestNewServiceFunc(t *testing.T) {
+	services := NewServiceFunc(t.TempDir(), DefaultInterfaceName)
+
+	if _, err := services(types.ServiceTypeWireGuard); err != nil {
+		t.Errorf("services(wireguard) error = %v", err)
+	}
+	if _, err := services(types.ServiceTypeV2Ray); err != nil {
+		t.Errorf("services(v2ray) error = %v", err)
+	}
+	if _, err := services(types.ServiceTypeUnspecified); err == nil {
+		t.Error("services(unspecified) succeeded, want an error")
+	}
+}
+
+func TestV2RayService_ClientOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		res     *types.HandshakeResult
+		want    uint16
+		wantErr bool
+	}{
+		{"inbound port", &types.HandshakeResult{Addrs: []string{"node.example"}, Data: []byte{0x1f, 0x90}}, 8080, false},
+		{"no addresses", &types.HandshakeResult{Data: []byte{0x1f, 0x90}}, 0, true},
+		{"data without port", &types.HandshakeResult{Addrs: []string{"node.example"}}, 0, true},
+		{"zero port", &types.HandshakeResult{Addrs: []string{"node.example"}, Data: []byte{0x00, 0x00}}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewV2RayService(t.TempDir(), DefaultInterfaceName)
+			if _, err := s.PeerRequest(); err != nil {
+				t.Fatal(err)
+			}
+
+			v, err := s.ClientOptions(tt.res)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ClientOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			opts := v.(*v2ray.ClientOptions)
+			if opts.Address != "node.example" || opts.Port != tt.want || opts.UUID != s.req.UUID.String() {
+				t.Errorf("ClientOptions() = %+v, want node.example:%d with the uuid of the peer request", opts, tt.want)
+			}
+		})
+	}
+}
+
+func TestV2RayService_ClientOptions_noPeerRequest(t *testing.T) {
+	s := NewV2RayService(t.TempDir(), DefaultInterfaceName)
+
+	res := &types.HandshakeResult{Addrs: []string{"node.example"}, Data: []byte{0x1f, 0x90}}
+	if _, err := s.ClientOptions(res); err == nil {
+		t.Error("ClientOptions() without a peer request succeeded")
+	}
+}
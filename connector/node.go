@@ -0,0 +1,127 @@
+package connector
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+)
+
+// Ensure HTTPNode implements the Node interface.
+var _ Node = (*HTTPNode)(nil)
+
+// HTTPNode is a Node reached over the HTTP API at its remote URL, signing handshakes with the key in the options.
+type HTTPNode struct {
+	c    *client.Client  // Client used to sign handshakes.
+	hc   *http.Client    // HTTP client used to reach the node API.
+	opts *client.Options // Options holding the signing key.
+}
+
+// NewHTTPNode creates a new HTTPNode using the given client and options.
+// Nodes serve their API with self-signed certificates, so the certificate is not verified.
+func NewHTTPNode(c *client.Client, opts *client.Options) *HTTPNode {
+	return &HTTPNode{
+		c: c,
+		hc: &http.Client{
+			Timeout: opts.GetTimeout(),
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: true,
+				},
+			},
+		},
+		opts: opts,
+	}
+}
+
+// do sends the request and decodes the result of the API response into v.
+func (n *HTTPNode) do(req *http.Request, v interface{}) error {
+	resp, err := n.hc.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	// Decode the response, keeping the result raw until success is known.
+	var res struct {
+		Success bool            `json:"success"`
+		Error   *types.Error    `json:"error"`
+		Result  json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(buf, &res); err != nil {
+		return fmt.Errorf("failed to decode response with status %d: %w", resp.StatusCode, err)
+	}
+	if !res.Success {
+		if res.Error == nil {
+			return fmt.Errorf("request failed with status %d", resp.StatusCode)
+		}
+
+		return fmt.Errorf("request failed with code %d: %s", res.Error.Code, res.Error.Message)
+	}
+
+	return json.Unmarshal(res.Result, v)
+}
+
+// Info returns the information of the node at the remote URL.
+func (n *HTTPNode) Info(ctx context.Context, remoteURL string) (*types.NodeInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, remoteURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var res types.NodeInfo
+	if err := n.do(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// Handshake signs the handshake request for the session with the key in the options,
+// and sends it to the node at the remote URL.
+func (n *HTTPNode) Handshake(ctx context.Context, remoteURL string, id uint64, data []byte) (*types.HandshakeResult, error) {
+	r := &types.HandshakeRequest{
+		Data: data,
+		ID:   id,
+	}
+
+	// Sign the request with the key of the session account.
+	signature, pubKey, err := n.c.Sign(n.opts.FromName, r.SignBytes(), n.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	r.PubKey = pubKey.Bytes()
+	r.Signature = signature
+
+	buf, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, remoteURL, bytes.NewReader(buf))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	var res types.HandshakeResult
+	if err := n.do(req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
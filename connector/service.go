@@ -0,0 +1,166 @@
+package connector
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+
+	"github.com/v2fly/v2ray-core/v5/common/uuid"
+
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+	"github.com/sentinel-official/sentinel-go-sdk/v2ray"
+	"github.com/sentinel-official/sentinel-go-sdk/wireguard"
+)
+
+// DefaultInterfaceName is the name of the interface, or configuration files, of the client service.
+const DefaultInterfaceName = "sentinel0"
+
+// wireGuardResultLen is the length of the WireGuard handshake result data: an IPv4 address (4 bytes),
+// an IPv6 address (16 bytes), the public key of the node (32 bytes) and its listen port (2 bytes).
+const wireGuardResultLen = 4 + 16 + wireguard.KeyLength + 2
+
+// v2RayResultLen is the length of the V2Ray handshake result data: the port of the inbound (2 bytes).
+const v2RayResultLen = 2
+
+// Ensure the services implement the Service interface.
+var (
+	_ Service = (*WireGuardService)(nil)
+	_ Service = (*V2RayService)(nil)
+)
+
+// WireGuardService prepares a WireGuard client for a connection.
+type WireGuardService struct {
+	client *wireguard.Client // Client brought up for the connection.
+	key    *wireguard.Key    // Private key of the client, generated for the handshake.
+}
+
+// NewWireGuardService creates a new WireGuardService with the given home directory and interface name.
+func NewWireGuardService(homeDir, name string) *WireGuardService {
+	return &WireGuardService{
+		client: wireguard.NewClient(homeDir, name),
+	}
+}
+
+// Client returns the WireGuard client.
+func (s *WireGuardService) Client() types.ClientService {
+	return s.client
+}
+
+// PeerRequest generates a new private key and returns its public key.
+func (s *WireGuardService) PeerRequest() ([]byte, error) {
+	key, err := wireguard.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	s.key = key
+	return key.Public()[:], nil
+}
+
+// ClientOptions builds the WireGuard client options from the handshake result.
+func (s *WireGuardService) ClientOptions(res *types.HandshakeResult) (interface{}, error) {
+	if s.key == nil {
+		return nil, errors.New("peer request has not been generated")
+	}
+	if len(res.Addrs) == 0 {
+		return nil, errors.New("handshake result has no addresses")
+	}
+	if len(res.Data) != wireGuardResultLen {
+		return nil, fmt.Errorf("handshake result data must be %d bytes", wireGuardResultLen)
+	}
+
+	// Split the data into the assigned addresses, the node public key and the node port.
+	ipv4Addr := netip.AddrFrom4([4]byte(res.Data[0:4]))
+	ipv6Addr := netip.AddrFrom16([16]byte(res.Data[4:20]))
+	pubKey := wireguard.Key(res.Data[20 : 20+wireguard.KeyLength])
+	port := binary.BigEndian.Uint16(res.Data[20+wireguard.KeyLength:])
+
+	opts := (&wireguard.ClientOptions{}).
+		WithAddresses(netip.PrefixFrom(ipv4Addr, 32).String(), netip.PrefixFrom(ipv6Addr, 128).String()).
+		WithPeerAllowedIPs("0.0.0.0/0", "::/0").
+		WithPeerEndpoint(net.JoinHostPort(res.Addrs[0], strconv.Itoa(int(port)))).
+		WithPeerPublicKey(pubKey.String()).
+		WithPrivateKey(s.key.String())
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
+
+// V2RayService prepares a V2Ray client for a connection.
+type V2RayService struct {
+	client *v2ray.Client         // Client brought up for the connection.
+	req    *v2ray.AddPeerRequest // Request adding the client as a peer, generated for the handshake.
+}
+
+// NewV2RayService creates a new V2RayService with the given home directory and name.
+func NewV2RayService(homeDir, name string) *V2RayService {
+	return &V2RayService{
+		client: v2ray.NewClient(homeDir, name),
+	}
+}
+
+// Client returns the V2Ray client.
+func (s *V2RayService) Client() types.ClientService {
+	return s.client
+}
+
+// PeerRequest generates a new user ID and returns the request adding it as a VMess peer over TCP.
+func (s *V2RayService) PeerRequest() ([]byte, error) {
+	s.req = &v2ray.AddPeerRequest{
+		Protocol: v2ray.ProtocolVMess,
+		Network:  v2ray.NetworkTCP,
+		Security: v2ray.SecurityNone,
+		UUID:     uuid.New(),
+	}
+
+	return s.req.Bytes(), nil
+}
+
+// ClientOptions builds the V2Ray client options from the handshake result.
+func (s *V2RayService) ClientOptions(res *types.HandshakeResult) (interface{}, error) {
+	if s.req == nil {
+		return nil, errors.New("peer request has not been generated")
+	}
+	if len(res.Addrs) == 0 {
+		return nil, errors.New("handshake result has no addresses")
+	}
+	if len(res.Data) != v2RayResultLen {
+		return nil, fmt.Errorf("handshake result data must be %d bytes", v2RayResultLen)
+	}
+
+	// The data is the port of the inbound the client was added to.
+	port := binary.BigEndian.Uint16(res.Data)
+
+	opts := (&v2ray.ClientOptions{}).
+		WithAddress(res.Addrs[0]).
+		WithNetwork(s.req.Network.String()).
+		WithPort(port).
+		WithProtocol(s.req.Protocol.String()).
+		WithProxyPort(v2ray.DefaultProxyPort).
+		WithSecurity(s.req.Security.String()).
+		WithUUID(s.req.UUID.String())
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
+
+// NewServiceFunc returns a ServiceFunc creating the services with the given home directory and interface name.
+func NewServiceFunc(homeDir, name string) ServiceFunc {
+	return func(t types.ServiceType) (Service, error) {
+		switch t {
+		case types.ServiceTypeWireGuard:
+			return NewWireGuardService(homeDir, name), nil
+		case types.ServiceTypeV2Ray:
+			return NewV2RayService(homeDir, name), nil
+		default:
+			return nil, fmt.Errorf("unsupported service type %d", t)
+		}
+	}
+}
@@ -0,0 +1,340 @@
+package denoms
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"sync"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Metadata describes a denomination with a base unit, used on the chain, and a display unit,
+// worth 10^Exponent base units.
+type Metadata struct {
+	Base     string `json:"base"`     // Base is the denomination of the base unit, such as udvpn.
+	Display  string `json:"display"`  // Display is the denomination of the display unit, such as dvpn.
+	Exponent uint32 `json:"exponent"` // Exponent is the power of 10 of base units in a display unit.
+}
+
+// DefaultMetadata lists the built-in denominations, available without querying the chain.
+var DefaultMetadata = []Metadata{
+	{Base: "udvpn", Display: "dvpn", Exponent: 6},
+}
+
+// Validate ensures the fields of the Metadata are valid.
+func (m Metadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return fmt.Errorf("invalid base denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(m.Display); err != nil {
+		return fmt.Errorf("invalid display denom: %w", err)
+	}
+	if m.Base == m.Display {
+		return errors.New("base and display denoms must differ")
+	}
+	if m.Exponent == 0 || m.Exponent > sdkmath.LegacyPrecision {
+		return fmt.Errorf("exponent must be between 1 and %d", sdkmath.LegacyPrecision)
+	}
+
+	return nil
+}
+
+// factor returns the number of base units in a display unit.
+func (m Metadata) factor() sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDec(10).Power(uint64(m.Exponent))
+}
+
+// MetadataFromBank returns the Metadata of the bank denomination metadata, using the exponent of its display unit.
+func MetadataFromBank(v banktypes.Metadata) (Metadata, error) {
+	for _, unit := range v.DenomUnits {
+		if unit.Denom == v.Display || containsAlias(unit.Aliases, v.Display) {
+			m := Metadata{
+				Base:     v.Base,
+				Display:  v.Display,
+				Exponent: unit.Exponent,
+			}
+
+			return m, m.Validate()
+		}
+	}
+
+	return Metadata{}, fmt.Errorf("display unit %s of denom %s does not exist", v.Display, v.Base)
+}
+
+// containsAlias reports whether the aliases contain the denomination.
+func containsAlias(aliases []string, denom string) bool {
+	for _, item := range aliases {
+		if item == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Registry holds the metadata of denominations and converts amounts between base and display units.
+// It is safe for concurrent use.
+type Registry struct {
+	mu        sync.RWMutex         // Mutex for synchronizing access to the metadata.
+	byBase    map[string]*Metadata // Metadata keyed by base denom.
+	byDisplay map[string]*Metadata // Metadata keyed by display denom.
+	traces    map[string]string    // Base denoms of IBC denoms, keyed by IBC denom.
+}
+
+// NewRegistry creates a new empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		byBase:    make(map[string]*Metadata),
+		byDisplay: make(map[string]*Metadata),
+		traces:    make(map[string]string),
+	}
+}
+
+// NewDefaultRegistry creates a new Registry holding the built-in denominations.
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+	if err := r.Register(DefaultMetadata...); err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// DefaultRegistry is the Registry used to parse the amounts of the options and to format amounts for display.
+// It holds the built-in denominations, and those registered from the chain with Client.RegisterDenomsMetadata.
+var DefaultRegistry = NewDefaultRegistry()
+
+// Register adds the metadata to the Registry, replacing the metadata with the same base denom.
+// It fails, registering nothing, if a denom would be both a base and a display denom, or the display
+// denom of two base denoms, as amounts in that denom could not be converted unambiguously.
+func (r *Registry) Register(items ...Metadata) error {
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("invalid metadata of denom %s: %w", item.Base, err)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Apply the items to copies of the maps, so nothing is registered if an item collides.
+	byBase := maps.Clone(r.byBase)
+	byDisplay := maps.Clone(r.byDisplay)
+
+	for _, item := range items {
+		item := item
+		if _, ok := byBase[item.Display]; ok {
+			return fmt.Errorf("display denom %s of %s is a registered base denom", item.Display, item.Base)
+		}
+		if v, ok := byDisplay[item.Base]; ok {
+			return fmt.Errorf("base denom %s is the display denom of %s", item.Base, v.Base)
+		}
+		if v, ok := byDisplay[item.Display]; ok && v.Base != item.Base {
+			return fmt.Errorf("display denom %s of %s is the display denom of %s", item.Display, item.Base, v.Base)
+		}
+
+		if prev, ok := byBase[item.Base]; ok {
+			delete(byDisplay, prev.Display)
+		}
+
+		byBase[item.Base] = &item
+		byDisplay[item.Display] = &item
+	}
+
+	r.byBase, r.byDisplay = byBase, byDisplay
+	return nil
+}
+
+// RegisterBankMetadata adds the bank denomination metadata to the Registry.
+// Metadata without a valid display unit is skipped, as the chain may hold metadata of denoms without one.
+func (r *Registry) RegisterBankMetadata(items ...banktypes.Metadata) error {
+	var list []Metadata
+	for _, item := range items {
+		m, err := MetadataFromBank(item)
+		if err != nil {
+			continue
+		}
+
+		list = append(list, m)
+	}
+
+	return r.Register(list...)
+}
+
+// RegisterTrace records the base denom an IBC denom, such as "ibc/27394FB0...", was transferred from,
+// so its amounts are shown with the base denom, in display units if the base denom is registered.
+func (r *Registry) RegisterTrace(denom, baseDenom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(baseDenom); err != nil {
+		return fmt.Errorf("invalid base denom of %s: %w", denom, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.traces[denom] = baseDenom
+	return nil
+}
+
+// Trace returns the base denom recorded for the IBC denom.
+func (r *Registry) Trace(denom string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	v, ok := r.traces[denom]
+	return v, ok
+}
+
+// Metadata returns the metadata of the denomination, looked up by base or display denom.
+func (r *Registry) Metadata(denom string) (Metadata, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if v, ok := r.byBase[denom]; ok {
+		return *v, true
+	}
+	if v, ok := r.byDisplay[denom]; ok {
+		return *v, true
+	}
+
+	return Metadata{}, false
+}
+
+// List returns the metadata of every denomination, sorted by base denom.
+func (r *Registry) List() []Metadata {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	items := make([]Metadata, 0, len(r.byBase))
+	for _, item := range r.byBase {
+		items = append(items, *item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Base < items[j].Base
+	})
+
+	return items
+}
+
+// ToBaseDec converts an amount in display units to base units. Amounts of other denoms are returned unchanged.
+func (r *Registry) ToBaseDec(coin sdk.DecCoin) sdk.DecCoin {
+	r.mu.RLock()
+	m, ok := r.byDisplay[coin.Denom]
+	r.mu.RUnlock()
+
+	if !ok {
+		return coin
+	}
+
+	return sdk.NewDecCoinFromDec(m.Base, coin.Amount.Mul(m.factor()))
+}
+
+// ToBase converts an amount in display units to a whole amount of base units.
+// It fails if the amount is not a whole number of base units.
+func (r *Registry) ToBase(coin sdk.DecCoin) (sdk.Coin, error) {
+	v := r.ToBaseDec(coin)
+	if !v.Amount.IsInteger() {
+		return sdk.Coin{}, fmt.Errorf("amount %s%s is not a whole number of %s", FormatDec(coin.Amount), coin.Denom, v.Denom)
+	}
+
+	return sdk.NewCoin(v.Denom, v.Amount.TruncateInt()), nil
+}
+
+// ToDisplay converts an amount in base units to display units. Amounts of other denoms are returned unchanged.
+func (r *Registry) ToDisplay(coin sdk.Coin) sdk.DecCoin {
+	return r.ToDisplayDec(sdk.NewDecCoinFromCoin(coin))
+}
+
+// ToDisplayDec converts a decimal amount in base units to display units. IBC denoms with a recorded trace are
+// replaced by their base denom first. Amounts of other denoms are returned unchanged.
+func (r *Registry) ToDisplayDec(coin sdk.DecCoin) sdk.DecCoin {
+	r.mu.RLock()
+	if v, ok := r.traces[coin.Denom]; ok {
+		coin.Denom = v
+	}
+	m, ok := r.byBase[coin.Denom]
+	r.mu.RUnlock()
+
+	if !ok {
+		return coin
+	}
+
+	return sdk.NewDecCoinFromDec(m.Display, coin.Amount.Quo(m.factor()))
+}
+
+// ParseCoin parses a coin in base or display units, such as "500000udvpn" or "0.5dvpn", into base units.
+// It fails if the amount is not a whole number of base units.
+func (r *Registry) ParseCoin(s string) (sdk.Coin, error) {
+	item, err := sdk.ParseDecCoin(s)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return r.ToBase(item)
+}
+
+// ParseCoins parses coins in base or display units, such as "500000udvpn" or "0.5dvpn", into base units.
+// Amounts of the same denom in both units are added up.
+func (r *Registry) ParseCoins(s string) (sdk.Coins, error) {
+	items, err := sdk.ParseDecCoins(s)
+	if err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins()
+	for _, item := range items {
+		coin, err := r.ToBase(item)
+		if err != nil {
+			return nil, err
+		}
+
+		coins = coins.Add(coin)
+	}
+
+	return coins, nil
+}
+
+// ParseDecCoins parses decimal coins in base or display units, such as "0.1udvpn" or "0.0000001dvpn", into base units.
+// Amounts of the same denom in both units are added up.
+func (r *Registry) ParseDecCoins(s string) (sdk.DecCoins, error) {
+	items, err := sdk.ParseDecCoins(s)
+	if err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewDecCoins()
+	for _, item := range items {
+		coins = coins.Add(r.ToBaseDec(item))
+	}
+
+	return coins, nil
+}
+
+// FormatDec formats the decimal without trailing zeros, such as "0.5" rather than "0.500000000000000000".
+func FormatDec(v sdkmath.LegacyDec) string {
+	s := v.String()
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	return s
+}
+
+// FormatCoins formats the coins in display units, such as "0.5dvpn,100foo".
+func (r *Registry) FormatCoins(coins sdk.Coins) string {
+	items := make([]string, 0, len(coins))
+	for _, coin := range coins {
+		v := r.ToDisplay(coin)
+		items = append(items, FormatDec(v.Amount)+v.Denom)
+	}
+
+	return strings.Join(items, ",")
+}
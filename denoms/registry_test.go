@@ -0,0 +1,151 @@
+package denoms
+
+import (
+	"reflect"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRegistry_Register(t *testing.T) {
+	tests := []struct {
+		name    string
+		items   []Metadata
+		wantErr bool
+	}{
+		{"new denom", []Metadata{{Base: "ufoo", Display: "foo", Exponent: 6}}, false},
+		{"replace display denom", []Metadata{{Base: "udvpn", Display: "mdvpn", Exponent: 3}}, false},
+		{"invalid exponent", []Metadata{{Base: "ufoo", Display: "foo", Exponent: 0}}, true},
+		{"display denom is a base denom", []Metadata{{Base: "ufoo", Display: "udvpn", Exponent: 6}}, true},
+		{"base denom is a display denom", []Metadata{{Base: "dvpn", Display: "kdvpn", Exponent: 3}}, true},
+		{"display denom of another base denom", []Metadata{{Base: "ufoo", Display: "dvpn", Exponent: 6}}, true},
+		{"collision within the items", []Metadata{{Base: "ufoo", Display: "foo", Exponent: 6}, {Base: "foo", Display: "kfoo", Exponent: 3}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewDefaultRegistry()
+
+			err := r.Register(tt.items...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Register() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				// Nothing is registered when an item fails.
+				if got := r.List(); !reflect.DeepEqual(got, DefaultMetadata) {
+					t.Errorf("List() = %+v, want %+v", got, DefaultMetadata)
+				}
+				return
+			}
+
+			for _, item := range tt.items {
+				if m, ok := r.Metadata(item.Display); !ok || m != item {
+					t.Errorf("Metadata(%s) = %+v, want %+v", item.Display, m, item)
+				}
+			}
+		})
+	}
+}
+
+func TestRegistry_Register_replace(t *testing.T) {
+	r := NewDefaultRegistry()
+	if err := r.Register(Metadata{Base: "udvpn", Display: "mdvpn", Exponent: 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The previous display denom is no longer registered, and can be used by another denom.
+	if _, ok := r.Metadata("dvpn"); ok {
+		t.Error("Metadata(dvpn) found after replacing its display denom")
+	}
+	if err := r.Register(Metadata{Base: "ufoo", Display: "dvpn", Exponent: 6}); err != nil {
+		t.Errorf("Register() error = %v", err)
+	}
+}
+
+func TestRegistry_ParseCoins(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{"base units", "500000udvpn", "500000udvpn", false},
+		{"display units", "0.5dvpn", "500000udvpn", false},
+		{"both units", "1dvpn,500000udvpn", "1500000udvpn", false},
+		{"other denom", "10foo", "10foo", false},
+		{"fraction of a base unit", "0.0000001dvpn", "", true},
+	}
+
+	r := NewDefaultRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.ParseCoins(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCoins() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("ParseCoins() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistry_ParseCoin(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{"base units", "500000udvpn", "500000udvpn", false},
+		{"display units", "0.5dvpn", "500000udvpn", false},
+		{"other denom", "10foo", "10foo", false},
+		{"fraction of a base unit", "0.0000001dvpn", "", true},
+		{"fraction of another denom", "0.5foo", "", true},
+		{"more than one coin", "1dvpn,10foo", "", true},
+	}
+
+	r := NewDefaultRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.ParseCoin(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCoin() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("ParseCoin() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistry_RegisterTrace(t *testing.T) {
+	const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	r := NewDefaultRegistry()
+	if err := r.RegisterTrace(ibcDenom, "uatom"); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.RegisterTrace(ibcDenom, "!"); err == nil {
+		t.Error("RegisterTrace() with an invalid base denom succeeded")
+	}
+
+	if v, ok := r.Trace(ibcDenom); !ok || v != "uatom" {
+		t.Errorf("Trace() = %s, %v, want uatom", v, ok)
+	}
+
+	// The traced amount is shown with its base denom, in display units once the base denom is registered.
+	coin := sdk.NewCoin(ibcDenom, sdkmath.NewInt(1500000))
+	if got := r.FormatCoins(sdk.NewCoins(coin)); got != "1500000uatom" {
+		t.Errorf("FormatCoins() = %s, want 1500000uatom", got)
+	}
+
+	if err := r.Register(Metadata{Base: "uatom", Display: "atom", Exponent: 6}); err != nil {
+		t.Fatal(err)
+	}
+	if got := r.FormatCoins(sdk.NewCoins(coin)); got != "1.5atom" {
+		t.Errorf("FormatCoins() = %s, want 1.5atom", got)
+	}
+}
@@ -0,0 +1,240 @@
+package discovery
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sentinel-official/hub/v12/x/node/types/v2"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/libs/geoip"
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+)
+
+// earthRadius is the mean radius of the Earth in kilometers.
+const earthRadius = 6371.0
+
+// Node holds an on-chain node together with the information it advertises.
+type Node struct {
+	v2.Node `json:"node"`
+
+	Info     *types.NodeInfo `json:"info,omitempty"`     // Info is the information reported by the node, if fetched.
+	Distance float64         `json:"distance,omitempty"` // Distance is the distance from the query origin in kilometers.
+}
+
+// Result represents a ranked and paginated set of discovered nodes.
+type Result struct {
+	Nodes []*Node `json:"nodes"` // Nodes is the requested page of ranked nodes.
+	Total uint64  `json:"total"` // Total is the number of nodes matching the query before pagination.
+}
+
+// Discoverer lists nodes from the chain and filters, ranks and paginates them.
+type Discoverer struct {
+	c           *client.Client  // Client used to query the chain.
+	fetcher     NodeInfoFetcher // Fetcher used to retrieve node information.
+	concurrency int             // Maximum number of concurrent node information requests.
+}
+
+// NewDiscoverer creates a new Discoverer using the given client and a default HTTP fetcher.
+func NewDiscoverer(c *client.Client) *Discoverer {
+	return &Discoverer{
+		c:           c,
+		fetcher:     NewHTTPNodeInfoFetcher(5 * time.Second),
+		concurrency: 16,
+	}
+}
+
+// WithFetcher sets the NodeInfoFetcher and returns the updated Discoverer.
+func (d *Discoverer) WithFetcher(v NodeInfoFetcher) *Discoverer {
+	d.fetcher = v
+	return d
+}
+
+// WithConcurrency sets the maximum number of concurrent node information requests and returns the updated Discoverer.
+func (d *Discoverer) WithConcurrency(v int) *Discoverer {
+	d.concurrency = v
+	return d
+}
+
+// listNodes retrieves every node matching the query status, and plan if set, walking through all pages.
+func (d *Discoverer) listNodes(ctx context.Context, q *Query, opts *client.Options) ([]v2.Node, error) {
+	return client.QueryAll(opts, func(opts *client.Options) ([]v2.Node, error) {
+		if q.PlanID != 0 {
+			return d.c.NodesForPlan(ctx, q.PlanID, q.Status, opts)
+		}
+
+		return d.c.Nodes(ctx, q.Status, opts)
+	})
+}
+
+// fetchInfos retrieves the information of the given nodes concurrently.
+// Nodes whose information cannot be retrieved are left with a nil Info.
+func (d *Discoverer) fetchInfos(ctx context.Context, nodes []*Node) {
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, max(d.concurrency, 1))
+	)
+
+	for _, node := range nodes {
+		wg.Add(1)
+		go func(node *Node) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			info, err := d.fetcher.Fetch(ctx, node.RemoteURL)
+			if err != nil {
+				return
+			}
+
+			node.Info = info
+		}(node)
+	}
+
+	wg.Wait()
+}
+
+// match reports whether the node satisfies the filters of the query.
+func (q *Query) match(node *Node) bool {
+	// Nodes not accepting the query denomination have no price to rank by.
+	if q.SortBy == SortByGigabytePrice && !node.GigabytePrices.AmountOf(q.Denom).IsPositive() {
+		return false
+	}
+	if q.SortBy == SortByHourlyPrice && !node.HourlyPrices.AmountOf(q.Denom).IsPositive() {
+		return false
+	}
+
+	// Check the price ceilings in the query denomination.
+	if !q.MaxGigabytePrice.IsNil() {
+		found, price := node.GigabytePrices.Find(q.Denom)
+		if !found || price.Amount.GT(q.MaxGigabytePrice) {
+			return false
+		}
+	}
+	if !q.MaxHourlyPrice.IsNil() {
+		found, price := node.HourlyPrices.Find(q.Denom)
+		if !found || price.Amount.GT(q.MaxHourlyPrice) {
+			return false
+		}
+	}
+
+	if !q.needsNodeInfo() {
+		return true
+	}
+
+	// The remaining filters depend on the node information.
+	if node.Info == nil {
+		return false
+	}
+	if q.ServiceType != types.ServiceTypeUnspecified && node.Info.Type != q.ServiceType {
+		return false
+	}
+	if len(q.Countries) > 0 || len(q.Cities) > 0 || q.SortBy == SortByDistance {
+		if node.Info.Location == nil {
+			return false
+		}
+		if len(q.Countries) > 0 && !containsFold(q.Countries, node.Info.Location.Country) {
+			return false
+		}
+		if len(q.Cities) > 0 && !containsFold(q.Cities, node.Info.Location.City) {
+			return false
+		}
+	}
+	if q.SortBy == SortByBandwidth && node.Info.Bandwidth == nil {
+		return false
+	}
+
+	return true
+}
+
+// less reports whether node i ranks before node j according to the query.
+func (q *Query) less(i, j *Node) bool {
+	switch q.SortBy {
+	case SortByGigabytePrice:
+		return i.GigabytePrices.AmountOf(q.Denom).LT(j.GigabytePrices.AmountOf(q.Denom))
+	case SortByHourlyPrice:
+		return i.HourlyPrices.AmountOf(q.Denom).LT(j.HourlyPrices.AmountOf(q.Denom))
+	case SortByDistance:
+		return i.Distance < j.Distance
+	case SortByBandwidth:
+		return bandwidth(i.Info.Bandwidth) > bandwidth(j.Info.Bandwidth)
+	default:
+		return false
+	}
+}
+
+// bandwidth returns the combined download and upload bandwidth.
+func bandwidth(v *types.Bandwidth) int64 {
+	return v.Download + v.Upload
+}
+
+// haversine returns the great-circle distance between two locations in kilometers.
+func haversine(a, b *geoip.Location) float64 {
+	toRadians := func(v float64) float64 { return v * math.Pi / 180 }
+
+	dLat := toRadians(b.Latitude - a.Latitude)
+	dLon := toRadians(b.Longitude - a.Longitude)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(a.Latitude))*math.Cos(toRadians(b.Latitude))*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+// Discover lists nodes from the chain, applies the query filters, ranks the matches and returns the requested page.
+func (d *Discoverer) Discover(ctx context.Context, q *Query, opts *client.Options) (*Result, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Retrieve the candidate nodes from the chain.
+	items, err := d.listNodes(ctx, q, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*Node, 0, len(items))
+	for _, item := range items {
+		nodes = append(nodes, &Node{Node: item})
+	}
+
+	// Retrieve the node information only when the query depends on it.
+	if q.needsNodeInfo() {
+		d.fetchInfos(ctx, nodes)
+	}
+
+	// Apply the filters and compute distances for the matching nodes.
+	matches := make([]*Node, 0, len(nodes))
+	for _, node := range nodes {
+		if !q.match(node) {
+			continue
+		}
+		if q.Origin != nil && node.Info != nil && node.Info.Location != nil {
+			node.Distance = haversine(q.Origin, node.Info.Location)
+		}
+
+		matches = append(matches, node)
+	}
+
+	// Rank the matching nodes, keeping the chain order for equal entries.
+	sort.SliceStable(matches, func(i, j int) bool {
+		return q.less(matches[i], matches[j])
+	})
+
+	// Apply the pagination window.
+	total := uint64(len(matches))
+	start := min(q.Offset, total)
+	end := total
+	if q.Limit > 0 {
+		end = min(start+q.Limit, total)
+	}
+
+	return &Result{
+		Nodes: matches[start:end],
+		Total: total,
+	}, nil
+}
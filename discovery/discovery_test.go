@@ -0,0 +1,226 @@
+package discovery
+
+import (
+	"math"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sentinel-official/hub/v12/x/node/types/v2"
+
+	"github.com/sentinel-official/sentinel-go-sdk/libs/geoip"
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+)
+
+func mustParseCoins(s string) sdk.Coins {
+	v, err := sdk.ParseCoinsNormalized(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func newTestNode(gigabytePrices, hourlyPrices string, info *types.NodeInfo) *Node {
+	return &Node{
+		Node: v2.Node{
+			GigabytePrices: mustParseCoins(gigabytePrices),
+			HourlyPrices:   mustParseCoins(hourlyPrices),
+		},
+		Info: info,
+	}
+}
+
+func TestQuery_match(t *testing.T) {
+	wireguard := &types.NodeInfo{
+		Type:     types.ServiceTypeWireGuard,
+		Location: &geoip.Location{City: "Berlin", Country: "Germany"},
+	}
+
+	tests := []struct {
+		name  string
+		query *Query
+		node  *Node
+		want  bool
+	}{
+		{
+			name:  "no filters",
+			query: NewQuery(),
+			node:  newTestNode("", "", nil),
+			want:  true,
+		},
+		{
+			name:  "gigabyte price under ceiling",
+			query: NewQuery().WithDenom("udvpn").WithMaxGigabytePrice(sdkmath.NewInt(100)),
+			node:  newTestNode("100udvpn", "", nil),
+			want:  true,
+		},
+		{
+			name:  "gigabyte price over ceiling",
+			query: NewQuery().WithDenom("udvpn").WithMaxGigabytePrice(sdkmath.NewInt(99)),
+			node:  newTestNode("100udvpn", "", nil),
+			want:  false,
+		},
+		{
+			name:  "hourly price ceiling without the denom",
+			query: NewQuery().WithDenom("udvpn").WithMaxHourlyPrice(sdkmath.NewInt(100)),
+			node:  newTestNode("", "10foo", nil),
+			want:  false,
+		},
+		{
+			name:  "sort by gigabyte price without the denom",
+			query: NewQuery().WithDenom("udvpn").WithSortBy(SortByGigabytePrice),
+			node:  newTestNode("10foo", "10udvpn", nil),
+			want:  false,
+		},
+		{
+			name:  "sort by hourly price without the denom",
+			query: NewQuery().WithDenom("udvpn").WithSortBy(SortByHourlyPrice),
+			node:  newTestNode("10udvpn", "", nil),
+			want:  false,
+		},
+		{
+			name:  "sort by hourly price with the denom",
+			query: NewQuery().WithDenom("udvpn").WithSortBy(SortByHourlyPrice),
+			node:  newTestNode("", "10udvpn", nil),
+			want:  true,
+		},
+		{
+			name:  "service type without info",
+			query: NewQuery().WithServiceType(types.ServiceTypeWireGuard),
+			node:  newTestNode("", "", nil),
+			want:  false,
+		},
+		{
+			name:  "service type mismatch",
+			query: NewQuery().WithServiceType(types.ServiceTypeV2Ray),
+			node:  newTestNode("", "", wireguard),
+			want:  false,
+		},
+		{
+			name:  "country ignoring case",
+			query: NewQuery().WithCountries("germany"),
+			node:  newTestNode("", "", wireguard),
+			want:  true,
+		},
+		{
+			name:  "city mismatch",
+			query: NewQuery().WithCities("Paris"),
+			node:  newTestNode("", "", wireguard),
+			want:  false,
+		},
+		{
+			name:  "sort by bandwidth without bandwidth",
+			query: NewQuery().WithSortBy(SortByBandwidth),
+			node:  newTestNode("", "", wireguard),
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.match(tt.node); got != tt.want {
+				t.Errorf("match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuery_less(t *testing.T) {
+	slow := &types.NodeInfo{Bandwidth: &types.Bandwidth{Download: 10, Upload: 10}}
+	fast := &types.NodeInfo{Bandwidth: &types.Bandwidth{Download: 100, Upload: 10}}
+
+	tests := []struct {
+		name  string
+		query *Query
+		i, j  *Node
+		want  bool
+	}{
+		{
+			name:  "cheaper gigabyte price",
+			query: NewQuery().WithDenom("udvpn").WithSortBy(SortByGigabytePrice),
+			i:     newTestNode("10udvpn", "", nil),
+			j:     newTestNode("20udvpn", "", nil),
+			want:  true,
+		},
+		{
+			name:  "dearer hourly price",
+			query: NewQuery().WithDenom("udvpn").WithSortBy(SortByHourlyPrice),
+			i:     newTestNode("", "30udvpn", nil),
+			j:     newTestNode("", "20udvpn", nil),
+			want:  false,
+		},
+		{
+			name:  "closer",
+			query: NewQuery().WithSortBy(SortByDistance),
+			i:     &Node{Distance: 1},
+			j:     &Node{Distance: 2},
+			want:  true,
+		},
+		{
+			name:  "more bandwidth",
+			query: NewQuery().WithSortBy(SortByBandwidth),
+			i:     newTestNode("", "", fast),
+			j:     newTestNode("", "", slow),
+			want:  true,
+		},
+		{
+			name:  "no sorting",
+			query: NewQuery(),
+			i:     newTestNode("10udvpn", "", nil),
+			j:     newTestNode("20udvpn", "", nil),
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.less(tt.i, tt.j); got != tt.want {
+				t.Errorf("less() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuery_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   *Query
+		wantErr bool
+	}{
+		{"default", NewQuery(), false},
+		{"price ceiling without denom", NewQuery().WithMaxGigabytePrice(sdkmath.NewInt(1)), true},
+		{"negative price ceiling", NewQuery().WithDenom("udvpn").WithMaxHourlyPrice(sdkmath.NewInt(-1)), true},
+		{"sort by price without denom", NewQuery().WithSortBy(SortByHourlyPrice), true},
+		{"sort by distance without origin", NewQuery().WithSortBy(SortByDistance), true},
+		{"sort by distance", NewQuery().WithSortBy(SortByDistance).WithOrigin(&geoip.Location{}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.query.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHaversine(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *geoip.Location
+		want float64
+	}{
+		{"same point", &geoip.Location{Latitude: 52.52, Longitude: 13.405}, &geoip.Location{Latitude: 52.52, Longitude: 13.405}, 0},
+		{"quarter meridian", &geoip.Location{}, &geoip.Location{Latitude: 90}, math.Pi * earthRadius / 2},
+		{"berlin to paris", &geoip.Location{Latitude: 52.52, Longitude: 13.405}, &geoip.Location{Latitude: 48.8566, Longitude: 2.3522}, 877.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := haversine(tt.a, tt.b); math.Abs(got-tt.want) > 1 {
+				t.Errorf("haversine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package discovery
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+)
+
+// NodeInfoFetcher is an interface for retrieving the information a node advertises at its remote URL.
+type NodeInfoFetcher interface {
+	Fetch(ctx context.Context, remoteURL string) (*types.NodeInfo, error)
+}
+
+// Ensure HTTPNodeInfoFetcher implements the NodeInfoFetcher interface.
+var _ NodeInfoFetcher = (*HTTPNodeInfoFetcher)(nil)
+
+// HTTPNodeInfoFetcher retrieves node information over HTTP from the node API.
+type HTTPNodeInfoFetcher struct {
+	c *http.Client
+}
+
+// NewHTTPNodeInfoFetcher creates and returns a new instance of HTTPNodeInfoFetcher with the specified timeout.
+// Nodes commonly serve self-signed certificates, so certificate verification is skipped.
+func NewHTTPNodeInfoFetcher(timeout time.Duration) *HTTPNodeInfoFetcher {
+	return &HTTPNodeInfoFetcher{
+		c: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: true, //nolint:gosec
+				},
+			},
+		},
+	}
+}
+
+// Fetch retrieves the node information served at the root of the given remote URL.
+func (f *HTTPNodeInfoFetcher) Fetch(ctx context.Context, remoteURL string) (*types.NodeInfo, error) {
+	// Build the HTTP GET request for the node API.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, remoteURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send the request to the node.
+	resp, err := f.c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check if the response status code indicates success.
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to retrieve data, status: %s", resp.Status)
+	}
+
+	// Parse the JSON response into a temporary structure.
+	var result struct {
+		Success bool            `json:"success"`
+		Error   *types.Error    `json:"error"`
+		Result  *types.NodeInfo `json:"result"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	// Check whether the node reported an error.
+	if !result.Success {
+		if result.Error != nil {
+			return nil, fmt.Errorf("node returned error %d: %s", result.Error.Code, result.Error.Message)
+		}
+
+		return nil, errors.New("node returned unsuccessful response")
+	}
+	if result.Result == nil {
+		return nil, errors.New("nil node info")
+	}
+
+	return result.Result, nil
+}
@@ -0,0 +1,200 @@
+package discovery
+
+import (
+	"errors"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+
+	"github.com/sentinel-official/sentinel-go-sdk/libs/geoip"
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+)
+
+// SortBy represents the criteria used to rank discovered nodes.
+type SortBy byte
+
+const (
+	SortByNone          SortBy = 0x00 + iota // SortByNone keeps the order returned by the chain.
+	SortByGigabytePrice                      // SortByGigabytePrice ranks nodes by ascending gigabyte price.
+	SortByHourlyPrice                        // SortByHourlyPrice ranks nodes by ascending hourly price.
+	SortByDistance                           // SortByDistance ranks nodes by ascending distance from the origin.
+	SortByBandwidth                          // SortByBandwidth ranks nodes by descending reported bandwidth.
+)
+
+// String returns the string representation of the SortBy.
+func (s SortBy) String() string {
+	switch s {
+	case SortByGigabytePrice:
+		return "gigabyte-price"
+	case SortByHourlyPrice:
+		return "hourly-price"
+	case SortByDistance:
+		return "distance"
+	case SortByBandwidth:
+		return "bandwidth"
+	default:
+		return "none"
+	}
+}
+
+// SortByFromString converts a string to a SortBy.
+func SortByFromString(s string) SortBy {
+	switch s {
+	case "gigabyte-price":
+		return SortByGigabytePrice
+	case "hourly-price":
+		return SortByHourlyPrice
+	case "distance":
+		return SortByDistance
+	case "bandwidth":
+		return SortByBandwidth
+	default:
+		return SortByNone
+	}
+}
+
+// Query describes the filters, ranking and pagination applied while discovering nodes.
+type Query struct {
+	Status      v1base.Status     `json:"status"`       // Status of the nodes on chain, unspecified matches any.
+	PlanID      uint64            `json:"plan_id"`      // PlanID restricts results to nodes linked to the plan, if non-zero.
+	ServiceType types.ServiceType `json:"service_type"` // ServiceType restricts results to nodes of the type, if specified.
+	Countries   []string          `json:"countries"`    // Countries restricts results to nodes located in one of the countries.
+	Cities      []string          `json:"cities"`       // Cities restricts results to nodes located in one of the cities.
+
+	Denom            string      `json:"denom"`              // Denom is the denomination in which prices are compared.
+	MaxGigabytePrice sdkmath.Int `json:"max_gigabyte_price"` // MaxGigabytePrice is the ceiling for the gigabyte price, if non-nil.
+	MaxHourlyPrice   sdkmath.Int `json:"max_hourly_price"`   // MaxHourlyPrice is the ceiling for the hourly price, if non-nil.
+
+	SortBy SortBy          `json:"sort_by"` // SortBy is the ranking criteria.
+	Origin *geoip.Location `json:"origin"`  // Origin is the location distances are measured from.
+
+	Offset uint64 `json:"offset"` // Offset is the number of ranked results to skip.
+	Limit  uint64 `json:"limit"`  // Limit is the maximum number of results to return, zero means no limit.
+}
+
+// NewQuery creates a new Query instance with default values.
+func NewQuery() *Query {
+	return &Query{
+		Status: v1base.StatusActive,
+		SortBy: SortByNone,
+	}
+}
+
+// WithStatus sets the Status field and returns the updated Query instance.
+func (q *Query) WithStatus(v v1base.Status) *Query {
+	q.Status = v
+	return q
+}
+
+// WithPlanID sets the PlanID field and returns the updated Query instance.
+func (q *Query) WithPlanID(v uint64) *Query {
+	q.PlanID = v
+	return q
+}
+
+// WithServiceType sets the ServiceType field and returns the updated Query instance.
+func (q *Query) WithServiceType(v types.ServiceType) *Query {
+	q.ServiceType = v
+	return q
+}
+
+// WithCountries sets the Countries field and returns the updated Query instance.
+func (q *Query) WithCountries(v ...string) *Query {
+	q.Countries = v
+	return q
+}
+
+// WithCities sets the Cities field and returns the updated Query instance.
+func (q *Query) WithCities(v ...string) *Query {
+	q.Cities = v
+	return q
+}
+
+// WithDenom sets the Denom field and returns the updated Query instance.
+func (q *Query) WithDenom(v string) *Query {
+	q.Denom = v
+	return q
+}
+
+// WithMaxGigabytePrice sets the MaxGigabytePrice field and returns the updated Query instance.
+func (q *Query) WithMaxGigabytePrice(v sdkmath.Int) *Query {
+	q.MaxGigabytePrice = v
+	return q
+}
+
+// WithMaxHourlyPrice sets the MaxHourlyPrice field and returns the updated Query instance.
+func (q *Query) WithMaxHourlyPrice(v sdkmath.Int) *Query {
+	q.MaxHourlyPrice = v
+	return q
+}
+
+// WithSortBy sets the SortBy field and returns the updated Query instance.
+func (q *Query) WithSortBy(v SortBy) *Query {
+	q.SortBy = v
+	return q
+}
+
+// WithOrigin sets the Origin field and returns the updated Query instance.
+func (q *Query) WithOrigin(v *geoip.Location) *Query {
+	q.Origin = v
+	return q
+}
+
+// WithOffset sets the Offset field and returns the updated Query instance.
+func (q *Query) WithOffset(v uint64) *Query {
+	q.Offset = v
+	return q
+}
+
+// WithLimit sets the Limit field and returns the updated Query instance.
+func (q *Query) WithLimit(v uint64) *Query {
+	q.Limit = v
+	return q
+}
+
+// hasPriceFilter reports whether any price ceiling is set.
+func (q *Query) hasPriceFilter() bool {
+	return !q.MaxGigabytePrice.IsNil() || !q.MaxHourlyPrice.IsNil()
+}
+
+// needsNodeInfo reports whether the node information must be fetched to evaluate the query.
+func (q *Query) needsNodeInfo() bool {
+	return q.ServiceType != types.ServiceTypeUnspecified ||
+		len(q.Countries) > 0 ||
+		len(q.Cities) > 0 ||
+		q.SortBy == SortByDistance ||
+		q.SortBy == SortByBandwidth
+}
+
+// Validate validates all fields of the Query struct.
+func (q *Query) Validate() error {
+	if q.hasPriceFilter() && q.Denom == "" {
+		return errors.New("denom must be non-empty when a price ceiling is set")
+	}
+	if !q.MaxGigabytePrice.IsNil() && q.MaxGigabytePrice.IsNegative() {
+		return errors.New("max_gigabyte_price must not be negative")
+	}
+	if !q.MaxHourlyPrice.IsNil() && q.MaxHourlyPrice.IsNegative() {
+		return errors.New("max_hourly_price must not be negative")
+	}
+	if (q.SortBy == SortByGigabytePrice || q.SortBy == SortByHourlyPrice) && q.Denom == "" {
+		return errors.New("denom must be non-empty when sorting by price")
+	}
+	if q.SortBy == SortByDistance && q.Origin == nil {
+		return errors.New("origin must be set when sorting by distance")
+	}
+
+	return nil
+}
+
+// containsFold reports whether s matches any of the items, ignoring case.
+func containsFold(items []string, s string) bool {
+	for _, item := range items {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,20 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Default values for governance options.
+const (
+	DefaultGovStatus = "voting_period"
+)
+
+// GetGovStatus retrieves the value of the status flag from the given command.
+func GetGovStatus(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("status")
+}
+
+// SetFlagGovStatus adds the status flag to the given command.
+func SetFlagGovStatus(cmd *cobra.Command) {
+	cmd.Flags().String("status", DefaultGovStatus, "Filter proposals by status (deposit_period, voting_period, passed, rejected or failed), or empty for all.")
+}
@@ -0,0 +1,28 @@
+package flags
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+// DefaultHomeDir is the directory holding the configuration file, ~/.sentinel by default.
+var DefaultHomeDir = func() string {
+	dir, err := os.UserHomeDir()
+	if err != nil {
+		return ".sentinel"
+	}
+
+	return filepath.Join(dir, ".sentinel")
+}()
+
+// GetHomeDir retrieves the "home" flag value from the command.
+func GetHomeDir(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("home")
+}
+
+// SetPersistentFlagHomeDir adds the "home" flag to the command and all of its sub-commands.
+func SetPersistentFlagHomeDir(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("home", DefaultHomeDir, "Directory holding the configuration file.")
+}
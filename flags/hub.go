@@ -0,0 +1,75 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Default values for hub module options.
+const (
+	DefaultHubGigabytePrices = ""
+	DefaultHubGigabytes      = 0
+	DefaultHubHourlyPrices   = ""
+	DefaultHubHours          = 0
+	DefaultHubRenewable      = false
+	DefaultHubStatus         = ""
+)
+
+// GetHubGigabytePrices retrieves the value of the gigabyte-prices flag from the given command.
+func GetHubGigabytePrices(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("gigabyte-prices")
+}
+
+// GetHubGigabytes retrieves the value of the gigabytes flag from the given command.
+func GetHubGigabytes(cmd *cobra.Command) (int64, error) {
+	return cmd.Flags().GetInt64("gigabytes")
+}
+
+// GetHubHourlyPrices retrieves the value of the hourly-prices flag from the given command.
+func GetHubHourlyPrices(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("hourly-prices")
+}
+
+// GetHubHours retrieves the value of the hours flag from the given command.
+func GetHubHours(cmd *cobra.Command) (int64, error) {
+	return cmd.Flags().GetInt64("hours")
+}
+
+// GetHubRenewable retrieves the value of the renewable flag from the given command.
+func GetHubRenewable(cmd *cobra.Command) (bool, error) {
+	return cmd.Flags().GetBool("renewable")
+}
+
+// GetHubStatus retrieves the value of the status flag from the given command.
+func GetHubStatus(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("status")
+}
+
+// SetFlagHubStatus adds the status flag to the given command.
+func SetFlagHubStatus(cmd *cobra.Command) {
+	cmd.Flags().String("status", DefaultHubStatus, "Filter by status (active, inactive_pending or inactive), or empty for all.")
+}
+
+// SetFlagHubGigabytePrices adds the gigabyte-prices flag to the given command.
+func SetFlagHubGigabytePrices(cmd *cobra.Command) {
+	cmd.Flags().String("gigabyte-prices", DefaultHubGigabytePrices, "Prices per gigabyte, as comma-separated coins.")
+}
+
+// SetFlagHubGigabytes adds the gigabytes flag to the given command.
+func SetFlagHubGigabytes(cmd *cobra.Command) {
+	cmd.Flags().Int64("gigabytes", DefaultHubGigabytes, "Number of gigabytes to pay for.")
+}
+
+// SetFlagHubHourlyPrices adds the hourly-prices flag to the given command.
+func SetFlagHubHourlyPrices(cmd *cobra.Command) {
+	cmd.Flags().String("hourly-prices", DefaultHubHourlyPrices, "Prices per hour, as comma-separated coins.")
+}
+
+// SetFlagHubHours adds the hours flag to the given command.
+func SetFlagHubHours(cmd *cobra.Command) {
+	cmd.Flags().Int64("hours", DefaultHubHours, "Number of hours to pay for.")
+}
+
+// SetFlagHubRenewable adds the renewable flag to the given command.
+func SetFlagHubRenewable(cmd *cobra.Command) {
+	cmd.Flags().Bool("renewable", DefaultHubRenewable, "Renew automatically when it expires.")
+}
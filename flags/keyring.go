@@ -6,9 +6,11 @@ import (
 
 // Default values for keyring flags.
 const (
-	DefaultKeyringAppName = "sentinel"
-	DefaultKeyringBackend = "test"
-	DefaultKeyringHomeDir = ""
+	DefaultKeyringAppName         = "sentinel"
+	DefaultKeyringBackend         = "test"
+	DefaultKeyringHomeDir         = ""
+	DefaultKeyringRemoteAddr      = ""
+	DefaultKeyringRemoteTokenFile = ""
 )
 
 // GetKeyringAppName retrieves the "keyring.app-name" flag value from the command.
@@ -26,6 +28,16 @@ func GetKeyringHomeDir(cmd *cobra.Command) (string, error) {
 	return cmd.Flags().GetString("keyring.home-dir")
 }
 
+// GetKeyringRemoteAddr retrieves the "keyring.remote-addr" flag value from the command.
+func GetKeyringRemoteAddr(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("keyring.remote-addr")
+}
+
+// GetKeyringRemoteTokenFile retrieves the "keyring.remote-token-file" flag value from the command.
+func GetKeyringRemoteTokenFile(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("keyring.remote-token-file")
+}
+
 // SetFlagKeyringAppName adds the "keyring.app-name" flag to the command.
 func SetFlagKeyringAppName(cmd *cobra.Command) {
 	cmd.Flags().String("keyring.app-name", DefaultKeyringAppName, "Name of the application.")
@@ -41,9 +53,21 @@ func SetFlagKeyringHomeDir(cmd *cobra.Command) {
 	cmd.Flags().String("keyring.home-dir", DefaultKeyringHomeDir, "Directory to store keys.")
 }
 
+// SetFlagKeyringRemoteAddr adds the "keyring.remote-addr" flag to the command.
+func SetFlagKeyringRemoteAddr(cmd *cobra.Command) {
+	cmd.Flags().String("keyring.remote-addr", DefaultKeyringRemoteAddr, "Address of the signer used by the remote backend (unix:///path or tcp://host:port).")
+}
+
+// SetFlagKeyringRemoteTokenFile adds the "keyring.remote-token-file" flag to the command.
+func SetFlagKeyringRemoteTokenFile(cmd *cobra.Command) {
+	cmd.Flags().String("keyring.remote-token-file", DefaultKeyringRemoteTokenFile, "File holding the token presented to the signer used by the remote backend.")
+}
+
 // AddKeyringFlags adds keyring-related flags to the given cobra command.
 func AddKeyringFlags(cmd *cobra.Command) {
 	SetFlagKeyringAppName(cmd)
 	SetFlagKeyringBackend(cmd)
 	SetFlagKeyringHomeDir(cmd)
+	SetFlagKeyringRemoteAddr(cmd)
+	SetFlagKeyringRemoteTokenFile(cmd)
 }
@@ -0,0 +1,176 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Default values for key management flags.
+const (
+	DefaultKeysAccountCount      = 1
+	DefaultKeysBech              = "acc"
+	DefaultKeysBIP39PassEnv      = ""
+	DefaultKeysDryRun            = false
+	DefaultKeysDstHomeDir        = ""
+	DefaultKeysIndexCount        = 10
+	DefaultKeysMnemonicEnv       = ""
+	DefaultKeysMnemonicFile      = ""
+	DefaultKeysMnemonicWordCount = 24
+	DefaultKeysPrivKeyEnv        = ""
+	DefaultKeysPrivKeyFile       = ""
+	DefaultKeysRecover           = false
+	DefaultKeysShareCount        = 0
+	DefaultKeysShareThreshold    = 0
+)
+
+// GetKeysAccountCount retrieves the "account-count" flag value from the command.
+func GetKeysAccountCount(cmd *cobra.Command) (uint32, error) {
+	return cmd.Flags().GetUint32("account-count")
+}
+
+// GetKeysBIP39PassEnv retrieves the "bip39-passphrase-env" flag value from the command.
+func GetKeysBIP39PassEnv(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("bip39-passphrase-env")
+}
+
+// GetKeysDryRun retrieves the "dry-run" flag value from the command.
+func GetKeysDryRun(cmd *cobra.Command) (bool, error) {
+	return cmd.Flags().GetBool("dry-run")
+}
+
+// GetKeysDstHomeDir retrieves the "dst-home-dir" flag value from the command.
+func GetKeysDstHomeDir(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("dst-home-dir")
+}
+
+// GetKeysIndexCount retrieves the "index-count" flag value from the command.
+func GetKeysIndexCount(cmd *cobra.Command) (uint32, error) {
+	return cmd.Flags().GetUint32("index-count")
+}
+
+// GetKeysMnemonicEnv retrieves the "mnemonic-env" flag value from the command.
+func GetKeysMnemonicEnv(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("mnemonic-env")
+}
+
+// GetKeysMnemonicFile retrieves the "mnemonic-file" flag value from the command.
+func GetKeysMnemonicFile(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("mnemonic-file")
+}
+
+// GetKeysMnemonicWordCount retrieves the "mnemonic-words" flag value from the command.
+func GetKeysMnemonicWordCount(cmd *cobra.Command) (int, error) {
+	return cmd.Flags().GetInt("mnemonic-words")
+}
+
+// GetKeysPrivKeyEnv retrieves the "private-key-env" flag value from the command.
+func GetKeysPrivKeyEnv(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("private-key-env")
+}
+
+// GetKeysPrivKeyFile retrieves the "private-key-file" flag value from the command.
+func GetKeysPrivKeyFile(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("private-key-file")
+}
+
+// GetKeysRecover retrieves the "recover" flag value from the command.
+func GetKeysRecover(cmd *cobra.Command) (bool, error) {
+	return cmd.Flags().GetBool("recover")
+}
+
+// SetFlagKeysAccountCount adds the "account-count" flag to the command.
+func SetFlagKeysAccountCount(cmd *cobra.Command) {
+	cmd.Flags().Uint32("account-count", DefaultKeysAccountCount, "Number of accounts to derive, starting at the key account.")
+}
+
+// SetFlagKeysBIP39PassEnv adds the "bip39-passphrase-env" flag to the command.
+func SetFlagKeysBIP39PassEnv(cmd *cobra.Command) {
+	cmd.Flags().String("bip39-passphrase-env", DefaultKeysBIP39PassEnv, "Environment variable holding the bip39 passphrase.")
+}
+
+// SetFlagKeysDryRun adds the "dry-run" flag to the command.
+func SetFlagKeysDryRun(cmd *cobra.Command) {
+	cmd.Flags().Bool("dry-run", DefaultKeysDryRun, "Show the key without storing it in the keyring.")
+}
+
+// SetFlagKeysDstHomeDir adds the "dst-home-dir" flag to the command.
+func SetFlagKeysDstHomeDir(cmd *cobra.Command) {
+	cmd.Flags().String("dst-home-dir", DefaultKeysDstHomeDir, "Directory of the destination keyring, defaults to the source directory.")
+}
+
+// SetFlagKeysIndexCount adds the "index-count" flag to the command.
+func SetFlagKeysIndexCount(cmd *cobra.Command) {
+	cmd.Flags().Uint32("index-count", DefaultKeysIndexCount, "Number of address indexes to derive per account, starting at the key index.")
+}
+
+// SetFlagKeysMnemonicEnv adds the "mnemonic-env" flag to the command.
+func SetFlagKeysMnemonicEnv(cmd *cobra.Command) {
+	cmd.Flags().String("mnemonic-env", DefaultKeysMnemonicEnv, "Environment variable holding the bip39 mnemonic.")
+}
+
+// SetFlagKeysMnemonicFile adds the "mnemonic-file" flag to the command.
+func SetFlagKeysMnemonicFile(cmd *cobra.Command) {
+	cmd.Flags().String("mnemonic-file", DefaultKeysMnemonicFile, "Path to a file holding the bip39 mnemonic.")
+}
+
+// SetFlagKeysMnemonicWordCount adds the "mnemonic-words" flag to the command.
+func SetFlagKeysMnemonicWordCount(cmd *cobra.Command) {
+	cmd.Flags().Int("mnemonic-words", DefaultKeysMnemonicWordCount, "Number of words of a generated mnemonic, either 12 or 24.")
+}
+
+// SetFlagKeysPrivKeyEnv adds the "private-key-env" flag to the command.
+func SetFlagKeysPrivKeyEnv(cmd *cobra.Command) {
+	cmd.Flags().String("private-key-env", DefaultKeysPrivKeyEnv, "Environment variable holding the hex encoded private key.")
+}
+
+// SetFlagKeysPrivKeyFile adds the "private-key-file" flag to the command.
+func SetFlagKeysPrivKeyFile(cmd *cobra.Command) {
+	cmd.Flags().String("private-key-file", DefaultKeysPrivKeyFile, "Path to a file holding the hex encoded private key.")
+}
+
+// SetFlagKeysRecover adds the "recover" flag to the command.
+func SetFlagKeysRecover(cmd *cobra.Command) {
+	cmd.Flags().Bool("recover", DefaultKeysRecover, "Recover the key from an existing mnemonic instead of generating one.")
+}
+
+// AddKeysMnemonicFlags adds the flags used to read a mnemonic non-interactively to the given cobra command.
+func AddKeysMnemonicFlags(cmd *cobra.Command) {
+	SetFlagKeysBIP39PassEnv(cmd)
+	SetFlagKeysMnemonicEnv(cmd)
+	SetFlagKeysMnemonicFile(cmd)
+}
+
+// AddKeysPrivKeyFlags adds the flags used to read a private key non-interactively to the given cobra command.
+func AddKeysPrivKeyFlags(cmd *cobra.Command) {
+	SetFlagKeysPrivKeyEnv(cmd)
+	SetFlagKeysPrivKeyFile(cmd)
+}
+
+// GetKeysBech retrieves the "bech" flag value from the command.
+func GetKeysBech(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("bech")
+}
+
+// SetFlagKeysBech adds the "bech" flag to the command.
+func SetFlagKeysBech(cmd *cobra.Command) {
+	cmd.Flags().String("bech", DefaultKeysBech, "Bech32 prefix of the shown address (acc|node|prov|val).")
+}
+
+// GetKeysShareCount retrieves the "shares" flag value from the command.
+func GetKeysShareCount(cmd *cobra.Command) (int, error) {
+	return cmd.Flags().GetInt("shares")
+}
+
+// GetKeysShareThreshold retrieves the "share-threshold" flag value from the command.
+func GetKeysShareThreshold(cmd *cobra.Command) (int, error) {
+	return cmd.Flags().GetInt("share-threshold")
+}
+
+// SetFlagKeysShareCount adds the "shares" flag to the command.
+func SetFlagKeysShareCount(cmd *cobra.Command) {
+	cmd.Flags().Int("shares", DefaultKeysShareCount, "Number of Shamir shares to split a generated mnemonic into, 0 to disable.")
+}
+
+// SetFlagKeysShareThreshold adds the "share-threshold" flag to the command.
+func SetFlagKeysShareThreshold(cmd *cobra.Command) {
+	cmd.Flags().Int("share-threshold", DefaultKeysShareThreshold, "Number of Shamir shares required to recover the mnemonic.")
+}
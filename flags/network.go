@@ -0,0 +1,18 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// DefaultNetwork is the name of the network profile used when none is selected.
+const DefaultNetwork = ""
+
+// GetNetwork retrieves the "network" flag value from the command.
+func GetNetwork(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("network")
+}
+
+// SetPersistentFlagNetwork adds the "network" flag to the command and all of its sub-commands.
+func SetPersistentFlagNetwork(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("network", DefaultNetwork, "Name of the network profile (mainnet, local or user-defined) to populate the query and tx options from.")
+}
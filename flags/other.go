@@ -5,12 +5,45 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// SetFlagOutputFormat adds a flag for specifying the output format to the given command.
+// Output formats supported in addition to the json and text formats of the keys package.
+const (
+	OutputFormatCSV       = "csv"
+	OutputFormatProtoJSON = "proto-json"
+	OutputFormatTable     = "table"
+)
+
+// OutputFormats lists every supported output format.
+var OutputFormats = []string{keys.OutputFormatJSON, keys.OutputFormatText, OutputFormatProtoJSON, OutputFormatTable, OutputFormatCSV}
+
+// SetFlagOutputFormat adds a flag for specifying the output format to the given command,
+// completing the supported output formats.
 func SetFlagOutputFormat(cmd *cobra.Command) {
-	cmd.Flags().String("output-format", keys.OutputFormatText, "Specify the output format (json or text)")
+	cmd.Flags().String("output-format", keys.OutputFormatText, "Specify the output format (json, text, proto-json, table or csv)")
+	_ = cmd.RegisterFlagCompletionFunc("output-format", cobra.FixedCompletions(OutputFormats, cobra.ShellCompDirectiveNoFileComp))
+}
+
+// SetFlagOutputDisplayUnits adds a flag for showing amounts in display units to the given command.
+func SetFlagOutputDisplayUnits(cmd *cobra.Command) {
+	cmd.Flags().Bool("output-display-units", false, "Show the amounts of known denoms in display units, such as dvpn instead of udvpn.")
 }
 
 // GetOutputFormat retrieves the output format flag value from the given command.
 func GetOutputFormat(cmd *cobra.Command) (string, error) {
 	return cmd.Flags().GetString("output-format")
 }
+
+// GetOutputDisplayUnits retrieves the output display units flag value from the given command.
+// It returns false if the command does not have the flag.
+func GetOutputDisplayUnits(cmd *cobra.Command) (bool, error) {
+	if cmd.Flags().Lookup("output-display-units") == nil {
+		return false, nil
+	}
+
+	return cmd.Flags().GetBool("output-display-units")
+}
+
+// AddOutputFlags attaches the output-related flags to the provided cobra command.
+func AddOutputFlags(cmd *cobra.Command) {
+	SetFlagOutputFormat(cmd)
+	SetFlagOutputDisplayUnits(cmd)
+}
@@ -6,6 +6,7 @@ import (
 
 // Default values for pagination flags.
 const (
+	DefaultPageAll        = false
 	DefaultPageCountTotal = false
 	DefaultPageKey        = ""
 	DefaultPageLimit      = 25
@@ -13,6 +14,11 @@ const (
 	DefaultPageReverse    = false
 )
 
+// GetPageAll retrieves the "all" flag value from the command.
+func GetPageAll(cmd *cobra.Command) (bool, error) {
+	return cmd.Flags().GetBool("all")
+}
+
 // GetPageCountTotal retrieves the "page.count-total" flag value from the command.
 func GetPageCountTotal(cmd *cobra.Command) (bool, error) {
 	return cmd.Flags().GetBool("page.count-total")
@@ -38,6 +44,11 @@ func GetPageReverse(cmd *cobra.Command) (bool, error) {
 	return cmd.Flags().GetBool("page.reverse")
 }
 
+// SetFlagPageAll adds the "all" flag to the command.
+func SetFlagPageAll(cmd *cobra.Command) {
+	cmd.Flags().Bool("all", DefaultPageAll, "Query all pages, ignoring the page offset and limit.")
+}
+
 // SetFlagPageCountTotal adds the "page.count-total" flag to the command.
 func SetFlagPageCountTotal(cmd *cobra.Command) {
 	cmd.Flags().Bool("page.count-total", DefaultPageCountTotal, "Include total count in paged queries.")
@@ -4,6 +4,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// DefaultQueryRPCAddrs is the default value of the "query.rpc-addrs" flag.
+var DefaultQueryRPCAddrs []string
+
 // Default values for query flags.
 const (
 	DefaultQueryHeight     = 0
@@ -39,6 +42,11 @@ func GetQueryRPCAddr(cmd *cobra.Command) (string, error) {
 	return cmd.Flags().GetString("query.rpc-addr")
 }
 
+// GetQueryRPCAddrs retrieves the "query.rpc-addrs" flag value from the command.
+func GetQueryRPCAddrs(cmd *cobra.Command) ([]string, error) {
+	return cmd.Flags().GetStringSlice("query.rpc-addrs")
+}
+
 // GetQueryTimeout retrieves the "query.timeout" flag value from the command.
 func GetQueryTimeout(cmd *cobra.Command) (string, error) {
 	return cmd.Flags().GetString("query.timeout")
@@ -69,6 +77,11 @@ func SetFlagQueryRPCAddr(cmd *cobra.Command) {
 	cmd.Flags().String("query.rpc-addr", DefaultQueryRPCAddr, "Address of the RPC server.")
 }
 
+// SetFlagQueryRPCAddrs adds the "query.rpc-addrs" flag to the command.
+func SetFlagQueryRPCAddrs(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("query.rpc-addrs", DefaultQueryRPCAddrs, "Comma-separated addresses of fallback RPC servers, queried in order when the RPC server fails.")
+}
+
 // SetFlagQueryTimeout adds the "query.timeout" flag to the command.
 func SetFlagQueryTimeout(cmd *cobra.Command) {
 	cmd.Flags().String("query.timeout", DefaultQueryTimeout, "Maximum duration for the query to be executed.")
@@ -81,5 +94,6 @@ func AddQueryFlags(cmd *cobra.Command) {
 	SetFlagQueryProve(cmd)
 	SetFlagQueryRetryDelay(cmd)
 	SetFlagQueryRPCAddr(cmd)
+	SetFlagQueryRPCAddrs(cmd)
 	SetFlagQueryTimeout(cmd)
 }
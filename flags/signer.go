@@ -0,0 +1,31 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Default values for signer flags.
+const (
+	DefaultSignerListenAddr = ""
+	DefaultSignerTokenFile  = ""
+)
+
+// GetSignerListenAddr retrieves the "signer.listen-addr" flag value from the command.
+func GetSignerListenAddr(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("signer.listen-addr")
+}
+
+// GetSignerTokenFile retrieves the "signer.token-file" flag value from the command.
+func GetSignerTokenFile(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("signer.token-file")
+}
+
+// SetFlagSignerListenAddr adds the "signer.listen-addr" flag to the command.
+func SetFlagSignerListenAddr(cmd *cobra.Command) {
+	cmd.Flags().String("signer.listen-addr", DefaultSignerListenAddr, "Address to serve signing requests on (unix:///path or tcp://127.0.0.1:port), a socket in the home directory by default.")
+}
+
+// SetFlagSignerTokenFile adds the "signer.token-file" flag to the command.
+func SetFlagSignerTokenFile(cmd *cobra.Command) {
+	cmd.Flags().String("signer.token-file", DefaultSignerTokenFile, "File holding the token clients must present, required for a tcp address.")
+}
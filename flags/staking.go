@@ -0,0 +1,20 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Default values for staking options.
+const (
+	DefaultStakingStatus = ""
+)
+
+// GetStakingStatus retrieves the value of the status flag from the given command.
+func GetStakingStatus(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("status")
+}
+
+// SetFlagStakingStatus adds the status flag to the given command.
+func SetFlagStakingStatus(cmd *cobra.Command) {
+	cmd.Flags().String("status", DefaultStakingStatus, "Filter validators by bond status (bonded, unbonding or unbonded).")
+}
@@ -80,7 +80,7 @@ func SetFlagTxFeeGranterAddr(cmd *cobra.Command) {
 
 // SetFlagTxFees adds the tx.fees flag to the given command.
 func SetFlagTxFees(cmd *cobra.Command) {
-	cmd.Flags().String("tx.fees", DefaultTxFees, "Transaction fees to be paid.")
+	cmd.Flags().String("tx.fees", DefaultTxFees, "Transaction fees to be paid, in base or display units (e.g. 500000udvpn or 0.5dvpn).")
 }
 
 // SetFlagTxFromName adds the tx.from-name flag to the given command.
@@ -100,7 +100,7 @@ func SetFlagTxGasAdjustment(cmd *cobra.Command) {
 
 // SetFlagTxGasPrices adds the tx.gas-prices flag to the given command.
 func SetFlagTxGasPrices(cmd *cobra.Command) {
-	cmd.Flags().String("tx.gas-prices", DefaultTxGasPrices, "Gas prices to be applied for transaction execution.")
+	cmd.Flags().String("tx.gas-prices", DefaultTxGasPrices, "Gas prices to be applied for transaction execution, in base or display units.")
 }
 
 // SetFlagTxMemo adds the tx.memo flag to the given command.
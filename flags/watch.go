@@ -0,0 +1,37 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Default values for watch flags.
+const (
+	DefaultWatch         = false
+	DefaultWatchInterval = "6s"
+)
+
+// GetWatch retrieves the "watch" flag value from the command.
+func GetWatch(cmd *cobra.Command) (bool, error) {
+	return cmd.Flags().GetBool("watch")
+}
+
+// GetWatchInterval retrieves the "watch.interval" flag value from the command.
+func GetWatchInterval(cmd *cobra.Command) (string, error) {
+	return cmd.Flags().GetString("watch.interval")
+}
+
+// SetPersistentFlagWatch adds the "watch" flag to the command and all of its sub-commands.
+func SetPersistentFlagWatch(cmd *cobra.Command) {
+	cmd.PersistentFlags().Bool("watch", DefaultWatch, "Re-run the query at the watch interval, printing only the changes until interrupted.")
+}
+
+// SetPersistentFlagWatchInterval adds the "watch.interval" flag to the command and all of its sub-commands.
+func SetPersistentFlagWatchInterval(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("watch.interval", DefaultWatchInterval, "Interval between the queries of the watch mode.")
+}
+
+// AddPersistentWatchFlags attaches the watch-related flags to the command and all of its sub-commands.
+func AddPersistentWatchFlags(cmd *cobra.Command) {
+	SetPersistentFlagWatch(cmd)
+	SetPersistentFlagWatchInterval(cmd)
+}
@@ -102,10 +102,14 @@ func (s *Scheduler) runWorker(w Worker) {
 				retries = 0
 			}
 
-			// Sleep before the next execution if the interval is positive.
+			// Sleep before the next execution if the interval is positive, waking up early on stop.
 			interval := w.Interval()
 			if interval > 0 {
-				time.Sleep(interval)
+				select {
+				case <-s.stopSignal:
+					return
+				case <-time.After(interval):
+				}
 			}
 		}
 	}
@@ -0,0 +1,170 @@
+package shamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// exp and log are the exponent and logarithm tables of GF(2^8) with the generator 3
+// and the AES reduction polynomial x^8 + x^4 + x^3 + x + 1.
+var (
+	exp [510]byte
+	log [256]byte
+)
+
+func init() {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		exp[i] = x
+		exp[i+255] = x
+		log[x] = byte(i)
+
+		// Multiply x by the generator 3 in GF(2^8).
+		hi := x & 0x80
+		x2 := x << 1
+		if hi != 0 {
+			x2 ^= 0x1b
+		}
+
+		x ^= x2
+	}
+}
+
+// mul multiplies two elements of GF(2^8).
+func mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	return exp[int(log[a])+int(log[b])]
+}
+
+// div divides two elements of GF(2^8). The divisor must not be zero.
+func div(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+
+	return exp[int(log[a])+255-int(log[b])]
+}
+
+// evaluate evaluates the polynomial with the given coefficients at x using Horner's method.
+func evaluate(coeffs []byte, x byte) byte {
+	var y byte
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		y = mul(y, x) ^ coeffs[i]
+	}
+
+	return y
+}
+
+// Split splits the secret into count shares, any threshold of which reconstruct it.
+// Each byte of the secret is the constant term of a random polynomial of degree threshold-1,
+// and share i holds the evaluation of every polynomial at x = i. Every share carries a random
+// ID of the split and a digest of the secret, so shares of different splits are not combined.
+func Split(secret []byte, threshold, count int) ([]*Share, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("secret must not be empty")
+	}
+	if threshold < 2 {
+		return nil, errors.New("threshold must be at least 2")
+	}
+	if count < threshold {
+		return nil, errors.New("count must not be less than threshold")
+	}
+	if count > 255 {
+		return nil, errors.New("count must not be greater than 255")
+	}
+
+	var id [idSize]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+
+	shares := make([]*Share, count)
+	for i := range shares {
+		shares[i] = &Share{
+			ID:        binary.BigEndian.Uint32(id[:]),
+			Threshold: byte(threshold),
+			Index:     byte(i + 1),
+			Digest:    digest(secret),
+			Data:      make([]byte, len(secret)),
+		}
+	}
+
+	coeffs := make([]byte, threshold)
+	for i, b := range secret {
+		// Build a random polynomial whose constant term is the secret byte.
+		coeffs[0] = b
+		if _, err := rand.Read(coeffs[1:]); err != nil {
+			return nil, err
+		}
+
+		for _, share := range shares {
+			share.Data[i] = evaluate(coeffs, share.Index)
+		}
+	}
+
+	return shares, nil
+}
+
+// Combine reconstructs the secret from at least threshold shares of the same split
+// using Lagrange interpolation at x = 0, and verifies it against the digest stored in the shares.
+func Combine(shares []*Share) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("no shares provided")
+	}
+
+	first := shares[0]
+	threshold := first.Threshold
+	size := len(first.Data)
+
+	// Check that every share belongs to the same split.
+	for _, share := range shares {
+		if share.ID != first.ID || share.Threshold != threshold || len(share.Data) != size ||
+			!bytes.Equal(share.Digest, first.Digest) {
+			return nil, errors.New("shares do not belong to the same split")
+		}
+	}
+	if len(shares) < int(threshold) {
+		return nil, fmt.Errorf("%d shares are required, got %d", threshold, len(shares))
+	}
+
+	// Use exactly threshold shares with distinct indexes.
+	shares = shares[:threshold]
+	seen := make(map[byte]bool)
+	for _, share := range shares {
+		if share.Index == 0 || seen[share.Index] {
+			return nil, fmt.Errorf("duplicate or invalid share index %d", share.Index)
+		}
+
+		seen[share.Index] = true
+	}
+
+	secret := make([]byte, size)
+	for i, share := range shares {
+		// Compute the Lagrange basis polynomial of the share evaluated at x = 0.
+		basis := byte(1)
+		for j, other := range shares {
+			if i == j {
+				continue
+			}
+
+			basis = mul(basis, div(other.Index, share.Index^other.Index))
+		}
+
+		for k := range secret {
+			secret[k] ^= mul(share.Data[k], basis)
+		}
+	}
+
+	// Verify the reconstructed secret against the digest stored in the shares.
+	if !bytes.Equal(digest(secret), first.Digest) {
+		return nil, errors.New("reconstructed secret does not match its digest, a share is invalid")
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,122 @@
+package shamir
+
+import (
+	"bytes"
+	"testing"
+)
+
+func mustSplit(t *testing.T, secret []byte, threshold, count int) []*Share {
+	t.Helper()
+
+	shares, err := Split(secret, threshold, count)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return shares
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    []byte
+		threshold int
+		count     int
+		wantErr   bool
+	}{
+		{"2 of 3", []byte("secret"), 2, 3, false},
+		{"255 shares", []byte("secret"), 3, 255, false},
+		{"empty secret", nil, 2, 3, true},
+		{"threshold of 1", []byte("secret"), 1, 3, true},
+		{"count below threshold", []byte("secret"), 3, 2, true},
+		{"too many shares", []byte("secret"), 2, 256, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares, err := Split(tt.secret, tt.threshold, tt.count)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Split() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && len(shares) != tt.count {
+				t.Errorf("Split() returned %d shares, want %d", len(shares), tt.count)
+			}
+		})
+	}
+}
+
+func TestCombine(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+
+	shares := mustSplit(t, secret, 3, 5)
+	other := mustSplit(t, secret, 3, 5)
+
+	tampered := *shares[2]
+	tampered.Data = append([]byte{tampered.Data[0] ^ 0x01}, tampered.Data[1:]...)
+
+	tests := []struct {
+		name    string
+		shares  []*Share
+		wantErr bool
+	}{
+		{"first shares", shares[:3], false},
+		{"last shares", shares[2:], false},
+		{"unordered shares", []*Share{shares[4], shares[0], shares[2]}, false},
+		{"more than threshold", shares, false},
+		{"no shares", nil, true},
+		{"below threshold", shares[:2], true},
+		{"duplicate index", []*Share{shares[0], shares[0], shares[1]}, true},
+		{"shares of another split", []*Share{shares[0], shares[1], other[2]}, true},
+		{"share of another split beyond threshold", []*Share{shares[0], shares[1], shares[2], other[3]}, true},
+		{"tampered share", []*Share{shares[0], shares[1], &tampered}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Combine(tt.shares)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Combine() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !bytes.Equal(got, secret) {
+				t.Errorf("Combine() = %x, want %x", got, secret)
+			}
+		})
+	}
+}
+
+func TestParseShare(t *testing.T) {
+	share := mustSplit(t, []byte("secret"), 2, 3)[1]
+	encoded := share.String()
+
+	corrupted := []byte(encoded)
+	if corrupted[len(corrupted)-1] == '0' {
+		corrupted[len(corrupted)-1] = '1'
+	} else {
+		corrupted[len(corrupted)-1] = '0'
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"valid", encoded, false},
+		{"surrounding whitespace", " " + encoded + "\n", false},
+		{"missing prefix", encoded[len(sharePrefix):], true},
+		{"invalid hex", sharePrefix + "zz", true},
+		{"too short", sharePrefix + "00010203", true},
+		{"corrupted", string(corrupted), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseShare(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseShare() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != encoded {
+				t.Errorf("ParseShare() = %s, want %s", got, encoded)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package shamir
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Constants for the text encoding of a share.
+const (
+	sharePrefix  = "sentshare1-"
+	checksumSize = 4
+	digestSize   = 4
+	idSize       = 4
+)
+
+// Share is a single share of a secret split with Split.
+type Share struct {
+	ID        uint32 `json:"id"`        // ID is the random identifier of the split the share belongs to.
+	Threshold byte   `json:"threshold"` // Threshold is the number of shares required to reconstruct the secret.
+	Index     byte   `json:"index"`     // Index is the x coordinate of the share, starting at 1.
+	Digest    []byte `json:"digest"`    // Digest is the prefix of the SHA-256 hash of the secret, verifying its reconstruction.
+	Data      []byte `json:"data"`      // Data holds the evaluations of the polynomials at the index.
+}
+
+// checksum returns the checksum of the encoded share payload.
+func checksum(payload []byte) []byte {
+	sum := sha256.Sum256(payload)
+	return sum[:checksumSize]
+}
+
+// digest returns the digest of the secret stored in every share.
+func digest(secret []byte) []byte {
+	sum := sha256.Sum256(secret)
+	return sum[:digestSize]
+}
+
+// String encodes the share as a prefixed hex string of the split ID, threshold, index, digest and data,
+// followed by a checksum used to detect corrupted shares.
+func (s *Share) String() string {
+	payload := binary.BigEndian.AppendUint32(nil, s.ID)
+	payload = append(payload, s.Threshold, s.Index)
+	payload = append(payload, s.Digest...)
+	payload = append(payload, s.Data...)
+	payload = append(payload, checksum(payload)...)
+
+	return sharePrefix + hex.EncodeToString(payload)
+}
+
+// ParseShare decodes a share encoded with Share.String and verifies its checksum.
+func ParseShare(v string) (*Share, error) {
+	v = strings.TrimSpace(v)
+	if !strings.HasPrefix(v, sharePrefix) {
+		return nil, fmt.Errorf("share must start with %s", sharePrefix)
+	}
+
+	buf, err := hex.DecodeString(strings.TrimPrefix(v, sharePrefix))
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) < idSize+2+digestSize+1+checksumSize {
+		return nil, errors.New("share is too short")
+	}
+
+	// Verify the checksum before trusting the payload.
+	payload, sum := buf[:len(buf)-checksumSize], buf[len(buf)-checksumSize:]
+	if !bytes.Equal(checksum(payload), sum) {
+		return nil, errors.New("share checksum mismatch, the share is corrupted")
+	}
+
+	return &Share{
+		ID:        binary.BigEndian.Uint32(payload),
+		Threshold: payload[idSize],
+		Index:     payload[idSize+1],
+		Digest:    payload[idSize+2 : idSize+2+digestSize],
+		Data:      payload[idSize+2+digestSize:],
+	}, nil
+}
@@ -0,0 +1,234 @@
+package signer
+
+import (
+	"errors"
+	"net"
+	"net/rpc"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// errUnsupported is returned by keyring operations that the remote signer does not expose.
+var errUnsupported = errors.New("operation is not supported by the remote signer")
+
+// Ensure Keyring implements the keyring.Keyring interface.
+var _ keyring.Keyring = (*Keyring)(nil)
+
+// Keyring is a keyring.Keyring that delegates key lookups and signing to a remote signer.
+// Operations that create, import, export or remove keys are not supported and must be
+// performed on the signer host.
+type Keyring struct {
+	network string
+	address string
+	token   string
+	cdc     codec.Codec
+}
+
+// NewKeyring creates a new Keyring connecting to the signer at the given address,
+// such as "unix:///run/signer.sock".
+func NewKeyring(addr string, cdc codec.Codec) (*Keyring, error) {
+	network, address, err := ParseAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Keyring{
+		network: network,
+		address: address,
+		cdc:     cdc,
+	}, nil
+}
+
+// WithToken sets the shared token presented to the signer and returns the updated Keyring.
+func (k *Keyring) WithToken(v string) *Keyring {
+	k.token = v
+	return k
+}
+
+// call dials the signer, presents the token, invokes the named method of the signing service and closes the connection.
+func (k *Keyring) call(method string, req, resp interface{}) error {
+	conn, err := net.Dial(k.network, k.address)
+	if err != nil {
+		return err
+	}
+
+	rwc, err := clientHandshake(conn, k.token)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	c := rpc.NewClient(rwc)
+	defer c.Close()
+
+	return c.Call(serviceName+"."+method, req, resp)
+}
+
+// record decodes a protobuf encoded keyring record.
+func (k *Keyring) record(buf []byte) (*keyring.Record, error) {
+	var record keyring.Record
+	if err := k.cdc.Unmarshal(buf, &record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// key requests the record identified by the request.
+func (k *Keyring) key(req KeyRequest) (*keyring.Record, error) {
+	var resp RecordResponse
+	if err := k.call("Key", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return k.record(resp.Record)
+}
+
+// sign requests a signature of the message by the key identified by the request.
+func (k *Keyring) sign(req KeyRequest, msg []byte) ([]byte, types.PubKey, error) {
+	var resp SignResponse
+	if err := k.call("Sign", SignRequest{KeyRequest: req, Msg: msg}, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	var pubKey types.PubKey
+	if err := k.cdc.UnmarshalInterface(resp.PubKey, &pubKey); err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Signature, pubKey, nil
+}
+
+// Backend returns the name of the remote signer backend.
+func (k *Keyring) Backend() string {
+	return BackendRemote
+}
+
+// List returns the records of all keys held by the signer.
+func (k *Keyring) List() ([]*keyring.Record, error) {
+	var resp ListResponse
+	if err := k.call("List", Empty{}, &resp); err != nil {
+		return nil, err
+	}
+
+	records := make([]*keyring.Record, 0, len(resp.Records))
+	for _, buf := range resp.Records {
+		record, err := k.record(buf)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// SupportedAlgorithms returns the signing algorithms supported by the signer.
+func (k *Keyring) SupportedAlgorithms() (keyring.SigningAlgoList, keyring.SigningAlgoList) {
+	return keyring.SigningAlgoList{hd.Secp256k1}, keyring.SigningAlgoList{}
+}
+
+// Key returns the record of the key with the given name.
+func (k *Keyring) Key(uid string) (*keyring.Record, error) {
+	return k.key(KeyRequest{Name: uid})
+}
+
+// KeyByAddress returns the record of the key with the given address.
+func (k *Keyring) KeyByAddress(address cosmossdk.Address) (*keyring.Record, error) {
+	return k.key(KeyRequest{Address: address.Bytes()})
+}
+
+// Sign signs the message with the key with the given name.
+func (k *Keyring) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	return k.sign(KeyRequest{Name: uid}, msg)
+}
+
+// SignByAddress signs the message with the key with the given address.
+func (k *Keyring) SignByAddress(address cosmossdk.Address, msg []byte) ([]byte, types.PubKey, error) {
+	return k.sign(KeyRequest{Address: address.Bytes()}, msg)
+}
+
+// Delete is not supported by the remote signer.
+func (k *Keyring) Delete(string) error {
+	return errUnsupported
+}
+
+// DeleteByAddress is not supported by the remote signer.
+func (k *Keyring) DeleteByAddress(cosmossdk.Address) error {
+	return errUnsupported
+}
+
+// Rename is not supported by the remote signer.
+func (k *Keyring) Rename(string, string) error {
+	return errUnsupported
+}
+
+// NewMnemonic is not supported by the remote signer.
+func (k *Keyring) NewMnemonic(string, keyring.Language, string, string, keyring.SignatureAlgo) (*keyring.Record, string, error) {
+	return nil, "", errUnsupported
+}
+
+// NewAccount is not supported by the remote signer.
+func (k *Keyring) NewAccount(string, string, string, string, keyring.SignatureAlgo) (*keyring.Record, error) {
+	return nil, errUnsupported
+}
+
+// SaveLedgerKey is not supported by the remote signer.
+func (k *Keyring) SaveLedgerKey(string, keyring.SignatureAlgo, string, uint32, uint32, uint32) (*keyring.Record, error) {
+	return nil, errUnsupported
+}
+
+// SaveOfflineKey is not supported by the remote signer.
+func (k *Keyring) SaveOfflineKey(string, types.PubKey) (*keyring.Record, error) {
+	return nil, errUnsupported
+}
+
+// SaveMultisig is not supported by the remote signer.
+func (k *Keyring) SaveMultisig(string, types.PubKey) (*keyring.Record, error) {
+	return nil, errUnsupported
+}
+
+// ImportPrivKey is not supported by the remote signer.
+func (k *Keyring) ImportPrivKey(string, string, string) error {
+	return errUnsupported
+}
+
+// ImportPrivKeyHex is not supported by the remote signer.
+func (k *Keyring) ImportPrivKeyHex(string, string, string) error {
+	return errUnsupported
+}
+
+// ImportPubKey is not supported by the remote signer.
+func (k *Keyring) ImportPubKey(string, string) error {
+	return errUnsupported
+}
+
+// ExportPubKeyArmor is not supported by the remote signer.
+func (k *Keyring) ExportPubKeyArmor(string) (string, error) {
+	return "", errUnsupported
+}
+
+// ExportPubKeyArmorByAddress is not supported by the remote signer.
+func (k *Keyring) ExportPubKeyArmorByAddress(cosmossdk.Address) (string, error) {
+	return "", errUnsupported
+}
+
+// ExportPrivKeyArmor is not supported by the remote signer.
+func (k *Keyring) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", errUnsupported
+}
+
+// ExportPrivKeyArmorByAddress is not supported by the remote signer.
+func (k *Keyring) ExportPrivKeyArmorByAddress(cosmossdk.Address, string) (string, error) {
+	return "", errUnsupported
+}
+
+// MigrateAll is not supported by the remote signer.
+func (k *Keyring) MigrateAll() ([]*keyring.Record, error) {
+	return nil, errUnsupported
+}
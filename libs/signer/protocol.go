@@ -0,0 +1,153 @@
+package signer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// BackendRemote is the keyring backend name of the remote signer.
+const BackendRemote = "remote"
+
+// serviceName is the name the signing service is registered under on the RPC server.
+const serviceName = "Signer"
+
+// Constants for the handshake opening every connection, in which the client presents its token.
+const (
+	handshakeOK      = "OK"             // handshakeOK is the reply of the server accepting the token.
+	handshakeTimeout = 10 * time.Second // handshakeTimeout bounds the time taken by the handshake.
+	maxTokenSize     = 4096             // maxTokenSize is the maximum size of a token, in bytes.
+)
+
+// DefaultAddr returns the default address of the signer, a unix socket in the "signer" directory of the home directory.
+func DefaultAddr(homeDir string) string {
+	return "unix://" + filepath.Join(homeDir, "signer", "signer.sock")
+}
+
+// ReadTokenFile reads the shared token from the file at the path, ignoring surrounding whitespace.
+// The file should only be readable by its owner.
+func ReadTokenFile(path string) (string, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	token := strings.TrimSpace(string(buf))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+	if len(token) > maxTokenSize {
+		return "", fmt.Errorf("token in file %s exceeds %d bytes", path, maxTokenSize)
+	}
+
+	return token, nil
+}
+
+// bufferedConn is a connection whose reads go through the buffer used to read the handshake,
+// so no bytes read ahead of the handshake are lost.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+// Read reads from the buffer of the connection.
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+// readLine reads a line of at most maxTokenSize bytes, without the trailing newline.
+func readLine(r *bufio.Reader) (string, error) {
+	var buf []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return "", err
+		}
+		if b == '\n' {
+			return string(buf), nil
+		}
+		if len(buf) >= maxTokenSize {
+			return "", errors.New("handshake line is too long")
+		}
+
+		buf = append(buf, b)
+	}
+}
+
+// clientHandshake presents the token on the connection and waits for the server to accept it.
+func clientHandshake(conn net.Conn, token string) (io.ReadWriteCloser, error) {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(conn, token+"\n"); err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReader(conn)
+
+	reply, err := readLine(r)
+	if err != nil {
+		return nil, fmt.Errorf("signer closed the connection during the handshake: %w", err)
+	}
+	if reply != handshakeOK {
+		return nil, errors.New("signer rejected the token")
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+
+	return &bufferedConn{Conn: conn, r: r}, nil
+}
+
+// Empty is the argument of RPC methods that take no input.
+type Empty struct{}
+
+// KeyRequest identifies a key by name or, if the name is empty, by address.
+type KeyRequest struct {
+	Name    string // Name is the name of the key.
+	Address []byte // Address is the address of the key.
+}
+
+// RecordResponse holds a protobuf encoded keyring record without private key material.
+type RecordResponse struct {
+	Record []byte // Record is the encoded keyring record.
+}
+
+// ListResponse holds the protobuf encoded records of all keys without private key material.
+type ListResponse struct {
+	Records [][]byte // Records are the encoded keyring records.
+}
+
+// SignRequest holds the bytes to be signed by the key identified by name or address.
+type SignRequest struct {
+	KeyRequest
+	Msg []byte // Msg is the bytes to sign.
+}
+
+// SignResponse holds a signature and the encoded public key of the key that produced it.
+type SignResponse struct {
+	Signature []byte // Signature is the signature of the message.
+	PubKey    []byte // PubKey is the encoded public key of the signing key.
+}
+
+// ParseAddr splits an address such as "unix:///run/signer.sock" or "tcp://127.0.0.1:9090"
+// into the network and address expected by the net package.
+func ParseAddr(v string) (network, address string, err error) {
+	network, address, ok := strings.Cut(v, "://")
+	if !ok || address == "" {
+		return "", "", fmt.Errorf("invalid signer address %s", v)
+	}
+
+	switch network {
+	case "unix", "tcp":
+		return network, address, nil
+	default:
+		return "", "", fmt.Errorf("unsupported signer network %s", network)
+	}
+}
@@ -0,0 +1,245 @@
+package signer
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/rpc"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Service exposes the signing operations of a local keyring over RPC.
+// Private keys never leave the service; records are returned as offline records holding only the public key.
+type Service struct {
+	cdc codec.Codec
+	kr  keyring.Keyring
+}
+
+// key returns the record identified by the request.
+func (s *Service) key(req KeyRequest) (*keyring.Record, error) {
+	if req.Name != "" {
+		return s.kr.Key(req.Name)
+	}
+
+	return s.kr.KeyByAddress(cosmossdk.AccAddress(req.Address))
+}
+
+// publicRecord encodes the given record as an offline record that holds no private key material.
+func (s *Service) publicRecord(v *keyring.Record) ([]byte, error) {
+	pubKey, err := v.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := keyring.NewOfflineRecord(v.Name, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.cdc.Marshal(record)
+}
+
+// List returns the public records of all keys.
+func (s *Service) List(_ Empty, resp *ListResponse) error {
+	records, err := s.kr.List()
+	if err != nil {
+		return err
+	}
+
+	resp.Records = make([][]byte, 0, len(records))
+	for _, record := range records {
+		buf, err := s.publicRecord(record)
+		if err != nil {
+			return err
+		}
+
+		resp.Records = append(resp.Records, buf)
+	}
+
+	return nil
+}
+
+// Key returns the public record of the key identified by the request.
+func (s *Service) Key(req KeyRequest, resp *RecordResponse) error {
+	record, err := s.key(req)
+	if err != nil {
+		return err
+	}
+
+	resp.Record, err = s.publicRecord(record)
+	return err
+}
+
+// Sign signs the message with the key identified by the request.
+func (s *Service) Sign(req SignRequest, resp *SignResponse) error {
+	record, err := s.key(req.KeyRequest)
+	if err != nil {
+		return err
+	}
+
+	signature, pubKey, err := s.kr.Sign(record.Name, req.Msg)
+	if err != nil {
+		return err
+	}
+
+	resp.Signature = signature
+	resp.PubKey, err = s.cdc.MarshalInterface(pubKey)
+	return err
+}
+
+// Server serves a Service to remote keyrings.
+type Server struct {
+	srv   *rpc.Server
+	token string
+}
+
+// NewServer creates a new Server that signs with the given local keyring.
+func NewServer(kr keyring.Keyring, cdc codec.Codec) (*Server, error) {
+	if kr.Backend() == BackendRemote {
+		return nil, errors.New("signer server requires a local keyring backend")
+	}
+
+	srv := rpc.NewServer()
+	if err := srv.RegisterName(serviceName, &Service{cdc: cdc, kr: kr}); err != nil {
+		return nil, err
+	}
+
+	return &Server{srv: srv}, nil
+}
+
+// WithToken sets the shared token clients must present before making requests and returns the updated Server.
+func (s *Server) WithToken(v string) *Server {
+	s.token = v
+	return s
+}
+
+// handshake reads the token presented by the client and replies whether it is accepted.
+// Any token is accepted if the Server has none.
+func (s *Server) handshake(conn net.Conn) (io.ReadWriteCloser, error) {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReader(conn)
+
+	token, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if s.token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+		_, _ = io.WriteString(conn, "UNAUTHORIZED\n")
+		return nil, errors.New("invalid token")
+	}
+	if _, err := io.WriteString(conn, handshakeOK+"\n"); err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+
+	return &bufferedConn{Conn: conn, r: r}, nil
+}
+
+// serveConn serves the requests of the connection once its token is accepted.
+func (s *Server) serveConn(conn net.Conn) {
+	rwc, err := s.handshake(conn)
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+
+	s.srv.ServeConn(rwc)
+}
+
+// Serve accepts connections on the listener until the context is done.
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	// Close the listener when the context is done to stop accepting connections.
+	go func() {
+		<-ctx.Done()
+		_ = l.Close()
+	}()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		go s.serveConn(conn)
+	}
+}
+
+// isLoopbackAddr reports whether the host of the tcp address is a loopback address.
+func isLoopbackAddr(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// ListenAndServe listens on the given address and serves connections until the context is done.
+// A unix socket is created with owner-only permissions, in a directory created with owner-only permissions
+// if it does not exist. A tcp address must be a loopback address, and the Server must have a token, as
+// any local user can connect to it.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	network, address, err := ParseAddr(addr)
+	if err != nil {
+		return err
+	}
+
+	var l net.Listener
+	switch network {
+	case "tcp":
+		if !isLoopbackAddr(address) {
+			return fmt.Errorf("signer tcp address %s must be a loopback address", address)
+		}
+		if s.token == "" {
+			return errors.New("signer requires a token to listen on a tcp address")
+		}
+
+		l, err = net.Listen(network, address)
+		if err != nil {
+			return err
+		}
+	case "unix":
+		if err := os.MkdirAll(filepath.Dir(address), 0o700); err != nil {
+			return err
+		}
+
+		// Remove a stale socket left behind by a previous run.
+		if err := os.Remove(address); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		l, err = listenUnix(address)
+		if err != nil {
+			return err
+		}
+		if err := os.Chmod(address, 0o600); err != nil {
+			_ = l.Close()
+			return err
+		}
+	}
+
+	return s.Serve(ctx, l)
+}
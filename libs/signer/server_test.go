@@ -0,0 +1,93 @@
+package signer
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+)
+
+func TestServer_handshake(t *testing.T) {
+	cdc := types.NewProtoCodec()
+
+	kr := keyring.NewInMemory(cdc)
+	if _, _, err := kr.NewMnemonic("alice", keyring.English, "m/44'/118'/0'/0/0", "", hd.Secp256k1); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		serverToken string
+		clientToken string
+		wantErr     bool
+	}{
+		{"no token", "", "", false},
+		{"matching token", "secret", "secret", false},
+		{"missing token", "secret", "", true},
+		{"wrong token", "secret", "guess", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := NewServer(kr, cdc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			l, err := net.Listen("unix", filepath.Join(t.TempDir(), "signer.sock"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			go func() { _ = server.WithToken(tt.serverToken).Serve(ctx, l) }()
+
+			remote, err := NewKeyring("unix://"+l.Addr().String(), cdc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, _, err = remote.WithToken(tt.clientToken).Sign("alice", []byte("msg"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sign() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServer_ListenAndServe(t *testing.T) {
+	cdc := types.NewProtoCodec()
+
+	tests := []struct {
+		name    string
+		addr    string
+		token   string
+		wantErr string
+	}{
+		{"tcp without token", "tcp://127.0.0.1:0", "", "requires a token"},
+		{"tcp on a public address", "tcp://0.0.0.0:0", "secret", "loopback"},
+		{"unsupported network", "udp://127.0.0.1:0", "", "unsupported"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := NewServer(keyring.NewInMemory(cdc), cdc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = server.WithToken(tt.token).ListenAndServe(context.Background(), tt.addr)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ListenAndServe() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+//go:build darwin || linux
+
+package signer
+
+import (
+	"net"
+	"syscall"
+)
+
+// listenUnix listens on the unix socket at the address. The socket is created with a umask denying
+// access to other users, so it is never reachable by them, even before its permissions are set.
+func listenUnix(address string) (net.Listener, error) {
+	mask := syscall.Umask(0o077)
+	defer syscall.Umask(mask)
+
+	return net.Listen("unix", address)
+}
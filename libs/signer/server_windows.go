@@ -0,0 +1,12 @@
+//go:build windows
+
+package signer
+
+import (
+	"net"
+)
+
+// listenUnix listens on the unix socket at the address.
+func listenUnix(address string) (net.Listener, error) {
+	return net.Listen("unix", address)
+}
@@ -0,0 +1,178 @@
+package node
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+)
+
+// maxRequestBodySize is the maximum size of a handshake request body.
+const maxRequestBodySize = 1 << 16
+
+// apiError is an error with the HTTP status and code returned by the node API.
+type apiError struct {
+	status int
+	code   int
+	err    error
+}
+
+// Error returns the message of the error.
+func (e *apiError) Error() string {
+	return e.err.Error()
+}
+
+// newAPIError creates a new apiError with the given HTTP status, code and formatted message.
+func newAPIError(status, code int, format string, args ...interface{}) *apiError {
+	return &apiError{
+		status: status,
+		code:   code,
+		err:    fmt.Errorf(format, args...),
+	}
+}
+
+// writeResponse writes the response as JSON with the given HTTP status.
+func writeResponse(w http.ResponseWriter, status int, res *types.Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// Handler returns the HTTP handler of the node API. A GET request returns the information of the node,
+// and a POST request performs the handshake adding the client as a peer of a session.
+func (n *Node) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeResponse(w, http.StatusOK, types.NewResponseResult(n.Info()))
+		case http.MethodPost:
+			res, err := n.handshake(r)
+			if err != nil {
+				var e *apiError
+				if !errors.As(err, &e) {
+					e = newAPIError(http.StatusInternalServerError, 1, "%s", err)
+				}
+
+				n.log.Error("Handshake failed", "error", err)
+				writeResponse(w, e.status, types.NewResponseError(e.code, e.Error()))
+				return
+			}
+
+			writeResponse(w, http.StatusOK, types.NewResponseResult(res))
+		default:
+			writeResponse(w, http.StatusMethodNotAllowed, types.NewResponseError(1, "method not allowed"))
+		}
+	})
+
+	return mux
+}
+
+// Info returns the information of the node, with the current number of peers.
+func (n *Node) Info() *types.NodeInfo {
+	info := *n.info
+	info.Peers = n.service.PeerCount()
+	return &info
+}
+
+// handshake verifies the handshake request against the session on the chain, and adds the client
+// as a peer of the session.
+func (n *Node) handshake(r *http.Request) (*types.HandshakeResult, error) {
+	buf, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBodySize))
+	if err != nil {
+		return nil, newAPIError(http.StatusBadRequest, 2, "failed to read request: %s", err)
+	}
+
+	var req types.HandshakeRequest
+	if err := json.Unmarshal(buf, &req); err != nil {
+		return nil, newAPIError(http.StatusBadRequest, 2, "failed to decode request: %s", err)
+	}
+
+	// Verify that the request is signed by the key in the request.
+	pubKey := &secp256k1.PubKey{Key: req.PubKey}
+	if len(req.PubKey) != secp256k1.PubKeySize || !pubKey.VerifySignature(req.SignBytes(), req.Signature) {
+		return nil, newAPIError(http.StatusUnauthorized, 3, "invalid signature")
+	}
+
+	// Verify that the session is active, on this node, and owned by the signer.
+	session, err := n.c.Session(r.Context(), req.ID, n.opts)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil, newAPIError(http.StatusNotFound, 4, "session %d does not exist", req.ID)
+		}
+
+		return nil, err
+	}
+	if !session.GetStatus().Equal(v1base.StatusActive) {
+		return nil, newAPIError(http.StatusBadRequest, 5, "session %d is not active", req.ID)
+	}
+	if session.GetNodeAddress() != n.addr.String() {
+		return nil, newAPIError(http.StatusBadRequest, 5, "session %d is not on this node", req.ID)
+	}
+	if session.GetAccAddress() != sdk.AccAddress(pubKey.Address()).String() {
+		return nil, newAPIError(http.StatusUnauthorized, 3, "session %d is not owned by the signer", req.ID)
+	}
+
+	addReq, key, err := addPeerRequest(n.cfg.GetServiceType(), req.Data)
+	if err != nil {
+		return nil, newAPIError(http.StatusBadRequest, 2, "invalid data: %s", err)
+	}
+
+	// Reserve the session, so concurrent handshakes cannot add a second peer for it.
+	n.mu.Lock()
+	if _, ok := n.peers[req.ID]; ok {
+		n.mu.Unlock()
+		return nil, newAPIError(http.StatusConflict, 6, "session %d already has a peer", req.ID)
+	}
+	if n.cfg.MaxPeers > 0 && len(n.peers) >= n.cfg.MaxPeers {
+		n.mu.Unlock()
+		return nil, newAPIError(http.StatusServiceUnavailable, 7, "maximum number of peers reached")
+	}
+
+	p := &peer{
+		ID:        req.ID,
+		Key:       key,
+		StartedAt: time.Now(),
+	}
+	n.peers[req.ID] = p
+	n.mu.Unlock()
+
+	// Add the peer to the service, releasing the session on failure.
+	res, err := n.service.AddPeer(r.Context(), addReq)
+	if err != nil {
+		n.mu.Lock()
+		delete(n.peers, req.ID)
+		n.mu.Unlock()
+
+		return nil, err
+	}
+
+	// Remove the added peer again if the result cannot be built.
+	res, err = n.handshakeData(addReq, res)
+	if err != nil {
+		if rerr := n.removePeer(r.Context(), p); rerr != nil {
+			n.log.Error("Failed to remove peer", "id", req.ID, "error", rerr)
+
+			n.mu.Lock()
+			delete(n.peers, req.ID)
+			n.mu.Unlock()
+		}
+
+		return nil, err
+	}
+
+	n.log.Info("Added peer", "id", req.ID, "acc_address", session.GetAccAddress())
+	return &types.HandshakeResult{
+		Addrs: n.cfg.RemoteAddrs,
+		Data:  res,
+	}, nil
+}
@@ -0,0 +1,342 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	"cosmossdk.io/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	base "github.com/sentinel-official/hub/v12/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	sessiontypes "github.com/sentinel-official/hub/v12/x/session/types/v3"
+	subscriptiontypes "github.com/sentinel-official/hub/v12/x/subscription/types/v3"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+	"github.com/sentinel-official/sentinel-go-sdk/wireguard"
+)
+
+// testNodeAddr is the address of the node under test.
+var testNodeAddr = base.NodeAddress([]byte("test-node-address-00"))
+
+// fakeChain is a Chain serving the given sessions and recording the broadcast messages.
+type fakeChain struct {
+	sessions map[uint64]*subscriptiontypes.Session
+	msgs     []sdk.Msg
+}
+
+func (c *fakeChain) Session(_ context.Context, id uint64, _ *client.Options) (sessiontypes.Session, error) {
+	session, ok := c.sessions[id]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound
+	}
+
+	return session, nil
+}
+
+func (c *fakeChain) BroadcastTx(_ context.Context, msgs []sdk.Msg, _ *client.Options) (*coretypes.ResultBroadcastTx, error) {
+	c.msgs = append(c.msgs, msgs...)
+	return &coretypes.ResultBroadcastTx{}, nil
+}
+
+// fakeService is a ServerService recording its peers, and failing the calls listed in errs.
+// The methods bringing the service up and down are not used by the tests.
+type fakeService struct {
+	types.ServerService
+	errs  map[string]error
+	peers map[string]bool
+	stats []*types.PeerStatistic
+}
+
+func (s *fakeService) AddPeer(_ context.Context, v interface{}) ([]byte, error) {
+	if err := s.errs["AddPeer"]; err != nil {
+		return nil, err
+	}
+
+	req := v.(*wireguard.AddPeerRequest)
+	s.peers[req.Key()] = true
+
+	// The assigned IPv4 and IPv6 addresses.
+	return make([]byte, 4+16), nil
+}
+
+func (s *fakeService) RemovePeer(_ context.Context, v interface{}) error {
+	if err := s.errs["RemovePeer"]; err != nil {
+		return err
+	}
+
+	req := v.(*wireguard.RemovePeerRequest)
+	delete(s.peers, req.Key())
+	return nil
+}
+
+func (s *fakeService) PeerCount() int {
+	return len(s.peers)
+}
+
+func (s *fakeService) PeerStatistics(_ context.Context) ([]*types.PeerStatistic, error) {
+	return s.stats, nil
+}
+
+// newTestNode creates a WireGuard Node backed by fakes, without peers.
+func newTestNode(t *testing.T, c *fakeChain, service *fakeService) *Node {
+	key, err := wireguard.NewPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := NewConfig()
+	cfg.RemoteAddrs = []string{"node.example"}
+	cfg.WireGuard = &wireguard.ServerOptions{
+		ListenPort: 51820,
+		PrivateKey: key.String(),
+	}
+
+	return &Node{
+		addr:    testNodeAddr,
+		c:       c,
+		cfg:     cfg,
+		info:    &types.NodeInfo{},
+		log:     log.NewNopLogger(),
+		opts:    &client.Options{},
+		service: service,
+		peers:   make(map[uint64]*peer),
+	}
+}
+
+// newTestSession returns a session of the account on the node, with the given status.
+func newTestSession(id uint64, accAddr sdk.AccAddress, nodeAddr base.NodeAddress, status v1base.Status) *subscriptiontypes.Session {
+	return &subscriptiontypes.Session{
+		ID:          id,
+		AccAddress:  accAddr.String(),
+		NodeAddress: nodeAddr.String(),
+		Status:      status,
+	}
+}
+
+// newTestRequest returns a handshake request for the session with a new WireGuard public key,
+// signed by the key.
+func newTestRequest(t *testing.T, key *secp256k1.PrivKey, id uint64) *types.HandshakeRequest {
+	peerKey, err := wireguard.NewPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &types.HandshakeRequest{
+		Data:   peerKey.Public()[:],
+		ID:     id,
+		PubKey: key.PubKey().Bytes(),
+	}
+
+	req.Signature, err = key.Sign(req.SignBytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return req
+}
+
+// postHandshake posts the handshake request to the node API, and returns the HTTP status and the response.
+func postHandshake(t *testing.T, n *Node, req *types.HandshakeRequest) (int, *types.Response) {
+	buf, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	n.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(buf)))
+
+	var res types.Response
+	if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+
+	return w.Code, &res
+}
+
+// peerIDs returns the sorted session IDs of the peers of the node.
+func peerIDs(n *Node) []uint64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	ids := make([]uint64, 0, len(n.peers))
+	for id := range n.peers {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func TestNode_handshake(t *testing.T) {
+	key := secp256k1.GenPrivKey()
+	accAddr := sdk.AccAddress(key.PubKey().Address())
+	otherAccAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otherNodeAddr := base.NodeAddress([]byte("test-node-address-01"))
+	errFailed := errors.New("failed")
+
+	tests := []struct {
+		name       string
+		session    *subscriptiontypes.Session
+		setup      func(n *Node, req *types.HandshakeRequest)
+		errs       map[string]error
+		wantStatus int
+		wantCode   int
+		wantPeers  []uint64
+	}{
+		{
+			name:       "success",
+			session:    newTestSession(1, accAddr, testNodeAddr, v1base.StatusActive),
+			wantStatus: http.StatusOK,
+			wantPeers:  []uint64{1},
+		},
+		{
+			name:       "wrong length public key",
+			session:    newTestSession(1, accAddr, testNodeAddr, v1base.StatusActive),
+			setup:      func(_ *Node, req *types.HandshakeRequest) { req.PubKey = req.PubKey[1:] },
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   3,
+		},
+		{
+			name:       "bad signature",
+			session:    newTestSession(1, accAddr, testNodeAddr, v1base.StatusActive),
+			setup:      func(_ *Node, req *types.HandshakeRequest) { req.ID = 2 },
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   3,
+		},
+		{
+			name:       "wrong length signature",
+			session:    newTestSession(1, accAddr, testNodeAddr, v1base.StatusActive),
+			setup:      func(_ *Node, req *types.HandshakeRequest) { req.Signature = req.Signature[1:] },
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   3,
+		},
+		{
+			name:       "unknown session",
+			wantStatus: http.StatusNotFound,
+			wantCode:   4,
+		},
+		{
+			name:       "inactive session",
+			session:    newTestSession(1, accAddr, testNodeAddr, v1base.StatusInactivePending),
+			wantStatus: http.StatusBadRequest,
+			wantCode:   5,
+		},
+		{
+			name:       "session on another node",
+			session:    newTestSession(1, accAddr, otherNodeAddr, v1base.StatusActive),
+			wantStatus: http.StatusBadRequest,
+			wantCode:   5,
+		},
+		{
+			name:       "session of another account",
+			session:    newTestSession(1, otherAccAddr, testNodeAddr, v1base.StatusActive),
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   3,
+		},
+		{
+			name:       "duplicate peer",
+			session:    newTestSession(1, accAddr, testNodeAddr, v1base.StatusActive),
+			setup:      func(n *Node, _ *types.HandshakeRequest) { n.peers[1] = &peer{ID: 1} },
+			wantStatus: http.StatusConflict,
+			wantCode:   6,
+			wantPeers:  []uint64{1},
+		},
+		{
+			name:    "maximum number of peers",
+			session: newTestSession(1, accAddr, testNodeAddr, v1base.StatusActive),
+			setup: func(n *Node, _ *types.HandshakeRequest) {
+				n.cfg.MaxPeers = 1
+				n.peers[2] = &peer{ID: 2}
+			},
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   7,
+			wantPeers:  []uint64{2},
+		},
+		{
+			name:       "add peer failure",
+			session:    newTestSession(1, accAddr, testNodeAddr, v1base.StatusActive),
+			errs:       map[string]error{"AddPeer": errFailed},
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   1,
+		},
+		{
+			name:       "handshake data failure",
+			session:    newTestSession(1, accAddr, testNodeAddr, v1base.StatusActive),
+			setup:      func(n *Node, _ *types.HandshakeRequest) { n.cfg.WireGuard.PrivateKey = "invalid" },
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   1,
+		},
+		{
+			name:       "handshake data and remove peer failure",
+			session:    newTestSession(1, accAddr, testNodeAddr, v1base.StatusActive),
+			setup:      func(n *Node, _ *types.HandshakeRequest) { n.cfg.WireGuard.PrivateKey = "invalid" },
+			errs:       map[string]error{"RemovePeer": errFailed},
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &fakeChain{sessions: make(map[uint64]*subscriptiontypes.Session)}
+			if tt.session != nil {
+				c.sessions[tt.session.ID] = tt.session
+			}
+
+			service := &fakeService{errs: tt.errs, peers: make(map[string]bool)}
+			n := newTestNode(t, c, service)
+
+			req := newTestRequest(t, key, 1)
+			if tt.setup != nil {
+				tt.setup(n, req)
+			}
+
+			status, res := postHandshake(t, n, req)
+			if status != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %+v", status, tt.wantStatus, res.Error)
+			}
+			if tt.wantCode != 0 && (res.Error == nil || res.Error.Code != tt.wantCode) {
+				t.Errorf("error = %+v, want code %d", res.Error, tt.wantCode)
+			}
+
+			// The session is reserved only by a successful handshake.
+			if got := peerIDs(n); !reflect.DeepEqual(got, append([]uint64{}, tt.wantPeers...)) {
+				t.Errorf("peers = %v, want %v", got, tt.wantPeers)
+			}
+			if tt.wantStatus != http.StatusOK {
+				if tt.errs["RemovePeer"] == nil && len(service.peers) != 0 {
+					t.Errorf("service peers = %v, want none", service.peers)
+				}
+				return
+			}
+
+			// The result holds the addresses of the peer, and the public key and listen port of the node.
+			buf, err := json.Marshal(res.Result)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var result types.HandshakeResult
+			if err := json.Unmarshal(buf, &result); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(result.Addrs, n.cfg.RemoteAddrs) || len(result.Data) != 4+16+wireguard.KeyLength+2 {
+				t.Errorf("result = %+v, want the remote addresses and %d bytes of data", result, 4+16+wireguard.KeyLength+2)
+			}
+			if len(service.peers) != 1 {
+				t.Errorf("service peers = %v, want one", service.peers)
+			}
+		})
+	}
+}
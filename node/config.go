@@ -0,0 +1,166 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/sentinel-go-sdk/denoms"
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+	"github.com/sentinel-official/sentinel-go-sdk/utils"
+	"github.com/sentinel-official/sentinel-go-sdk/v2ray"
+	"github.com/sentinel-official/sentinel-go-sdk/wireguard"
+)
+
+// Constants for locating the node configuration.
+const (
+	ConfigFileName = "node" // ConfigFileName is the name of the node configuration file, without extension.
+)
+
+// configFileExts lists the supported node configuration file extensions, in the order they are looked up.
+var configFileExts = []string{".toml", ".yaml", ".yml", ".json"}
+
+// Config represents the configuration of a node.
+type Config struct {
+	APIListenAddr          string                   `json:"api_listen_addr"`          // APIListenAddr is the address the node API listens on.
+	APITLSCertPath         string                   `json:"api_tls_cert_path"`        // APITLSCertPath is the path of the TLS certificate of the node API, if served over TLS.
+	APITLSKeyPath          string                   `json:"api_tls_key_path"`         // APITLSKeyPath is the path of the TLS key of the node API, if served over TLS.
+	GigabytePrices         string                   `json:"gigabyte_prices"`          // GigabytePrices is the prices per gigabyte advertised by the node.
+	HourlyPrices           string                   `json:"hourly_prices"`            // HourlyPrices is the prices per hour advertised by the node.
+	IntervalUpdateSessions string                   `json:"interval_update_sessions"` // IntervalUpdateSessions is the interval between session updates on the chain.
+	IntervalUpdateStatus   string                   `json:"interval_update_status"`   // IntervalUpdateStatus is the interval between status updates on the chain.
+	MaxPeers               int                      `json:"max_peers"`                // MaxPeers is the maximum number of peers, or zero for no limit.
+	Moniker                string                   `json:"moniker"`                  // Moniker is the name of the node.
+	RemoteAddrs            []string                 `json:"remote_addrs"`             // RemoteAddrs are the public addresses clients connect to.
+	ServiceType            string                   `json:"service_type"`             // ServiceType is the type of the service, wireguard or v2ray.
+	V2Ray                  *v2ray.ServerOptions     `json:"v2ray"`                    // V2Ray holds the V2Ray server options.
+	WireGuard              *wireguard.ServerOptions `json:"wireguard"`                // WireGuard holds the WireGuard server options.
+}
+
+// NewConfig creates a new Config instance with default values.
+func NewConfig() *Config {
+	return &Config{
+		APIListenAddr:          "0.0.0.0:7777",
+		IntervalUpdateSessions: "5m",
+		IntervalUpdateStatus:   "55m",
+		ServiceType:            types.ServiceTypeWireGuard.String(),
+	}
+}
+
+// GetGigabytePrices returns the prices per gigabyte.
+func (c *Config) GetGigabytePrices() sdk.Coins {
+	v, err := denoms.DefaultRegistry.ParseCoins(c.GigabytePrices)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// GetHourlyPrices returns the prices per hour.
+func (c *Config) GetHourlyPrices() sdk.Coins {
+	v, err := denoms.DefaultRegistry.ParseCoins(c.HourlyPrices)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// GetIntervalUpdateSessions returns the interval between session updates.
+func (c *Config) GetIntervalUpdateSessions() time.Duration {
+	v, err := time.ParseDuration(c.IntervalUpdateSessions)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// GetIntervalUpdateStatus returns the interval between status updates.
+func (c *Config) GetIntervalUpdateStatus() time.Duration {
+	v, err := time.ParseDuration(c.IntervalUpdateStatus)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// GetServiceType returns the type of the service.
+func (c *Config) GetServiceType() types.ServiceType {
+	return types.ServiceTypeFromString(c.ServiceType)
+}
+
+// Validate ensures the fields of the Config are valid.
+func (c *Config) Validate() error {
+	if _, _, err := net.SplitHostPort(c.APIListenAddr); err != nil {
+		return fmt.Errorf("api_listen_addr must be a valid address: %w", err)
+	}
+	if (c.APITLSCertPath == "") != (c.APITLSKeyPath == "") {
+		return errors.New("api_tls_cert_path and api_tls_key_path must be set together")
+	}
+	if _, err := denoms.DefaultRegistry.ParseCoins(c.GigabytePrices); err != nil {
+		return fmt.Errorf("gigabyte_prices must be valid coins: %w", err)
+	}
+	if _, err := denoms.DefaultRegistry.ParseCoins(c.HourlyPrices); err != nil {
+		return fmt.Errorf("hourly_prices must be valid coins: %w", err)
+	}
+	if d, err := time.ParseDuration(c.IntervalUpdateSessions); err != nil || d <= 0 {
+		return errors.New("interval_update_sessions must be a positive duration")
+	}
+	if d, err := time.ParseDuration(c.IntervalUpdateStatus); err != nil || d <= 0 {
+		return errors.New("interval_update_status must be a positive duration")
+	}
+	if c.MaxPeers < 0 {
+		return errors.New("max_peers must not be negative")
+	}
+	if len(c.RemoteAddrs) == 0 {
+		return errors.New("remote_addrs must not be empty")
+	}
+
+	switch c.GetServiceType() {
+	case types.ServiceTypeWireGuard:
+		if c.WireGuard == nil {
+			return errors.New("wireguard must be set for the wireguard service")
+		}
+		if err := c.WireGuard.Validate(); err != nil {
+			return fmt.Errorf("wireguard validation failed: %w", err)
+		}
+	case types.ServiceTypeV2Ray:
+		if c.V2Ray == nil {
+			return errors.New("v2ray must be set for the v2ray service")
+		}
+		if err := c.V2Ray.Validate(); err != nil {
+			return fmt.Errorf("v2ray validation failed: %w", err)
+		}
+	default:
+		return errors.New("service_type must be wireguard or v2ray")
+	}
+
+	return nil
+}
+
+// LoadConfig reads the node configuration file in the home directory on top of the defaults.
+func LoadConfig(homeDir string) (*Config, error) {
+	path, err := utils.FindFile(homeDir, ConfigFileName, configFileExts...)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return nil, fmt.Errorf("node configuration file %s.toml does not exist in %s", ConfigFileName, homeDir)
+	}
+
+	cfg := NewConfig()
+	if err := utils.DecodeFile(path, cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid node configuration %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,256 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	base "github.com/sentinel-official/hub/v12/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	v3nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v3"
+	sessiontypes "github.com/sentinel-official/hub/v12/x/session/types/v3"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/libs/cron"
+	"github.com/sentinel-official/sentinel-go-sdk/libs/geoip"
+	"github.com/sentinel-official/sentinel-go-sdk/libs/speedtest"
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+	"github.com/sentinel-official/sentinel-go-sdk/v2ray"
+	"github.com/sentinel-official/sentinel-go-sdk/wireguard"
+)
+
+// shutdownTimeout bounds the time spent shutting down the node API and marking the node inactive.
+const shutdownTimeout = 15 * time.Second
+
+// peer represents a client added as a peer of the service for a session.
+type peer struct {
+	ID        uint64    // ID is the identifier of the session.
+	Key       string    // Key identifies the peer in the service.
+	StartedAt time.Time // StartedAt is the time the peer was added.
+}
+
+// Chain is the part of the hub used by the node. It can be replaced by a fake chain for testing.
+type Chain interface {
+	Session(ctx context.Context, id uint64, opts *client.Options) (sessiontypes.Session, error)                  // Session returns the session with the given ID.
+	BroadcastTx(ctx context.Context, msgs []sdk.Msg, opts *client.Options) (*coretypes.ResultBroadcastTx, error) // BroadcastTx broadcasts the messages signed by the key in the options.
+}
+
+// Node runs a dVPN node: it brings the service up, serves the node API, and keeps the node
+// status and the sessions of its peers updated on the chain.
+type Node struct {
+	addr    base.NodeAddress    // Address of the node.
+	c       Chain               // Chain used to query the sessions and broadcast transactions.
+	cfg     *Config             // Configuration of the node.
+	info    *types.NodeInfo     // Information served by the node API.
+	log     log.Logger          // Logger of the node.
+	opts    *client.Options     // Options used for queries and transactions.
+	service types.ServerService // Service peers are added to.
+
+	mu    sync.Mutex       // Mutex for synchronizing access to the peers.
+	peers map[uint64]*peer // Peers of the active sessions, keyed by session ID.
+}
+
+// New creates a new Node for the key in the options, with the service of the configuration.
+func New(homeDir string, cfg *Config, c *client.Client, opts *client.Options, logger log.Logger) (*Node, error) {
+	accAddr, err := c.FromAddr(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize the service of the configured type.
+	var service types.ServerService
+	switch cfg.GetServiceType() {
+	case types.ServiceTypeWireGuard:
+		pm, err := wireguard.NewPeerManagerFromAddresses(cfg.WireGuard.Addresses)
+		if err != nil {
+			return nil, err
+		}
+
+		service = wireguard.NewServer(homeDir, cfg.WireGuard.Interface, pm)
+	case types.ServiceTypeV2Ray:
+		service = v2ray.NewServer(homeDir)
+	default:
+		return nil, fmt.Errorf("unsupported service type %s", cfg.ServiceType)
+	}
+
+	return &Node{
+		addr:    base.NodeAddress(accAddr.Bytes()),
+		c:       c,
+		cfg:     cfg,
+		log:     logger,
+		opts:    opts,
+		service: service,
+		peers:   make(map[uint64]*peer),
+	}, nil
+}
+
+// serverOptions returns the options passed to PreUp of the service.
+func (n *Node) serverOptions() interface{} {
+	if n.cfg.GetServiceType() == types.ServiceTypeV2Ray {
+		return n.cfg.V2Ray
+	}
+
+	return n.cfg.WireGuard
+}
+
+// newInfo builds the information served by the node API. The location and bandwidth are measured
+// once, and left empty if the measurement fails.
+func (n *Node) newInfo() *types.NodeInfo {
+	info := &types.NodeInfo{
+		Address:                n.addr,
+		Handshake:              &types.Handshake{},
+		IntervalUpdateSessions: n.cfg.GetIntervalUpdateSessions(),
+		IntervalUpdateStatus:   n.cfg.GetIntervalUpdateStatus(),
+		Moniker:                n.cfg.Moniker,
+		Operator:               sdk.AccAddress(n.addr.Bytes()),
+		GigabytePrices:         n.cfg.GetGigabytePrices(),
+		HourlyPrices:           n.cfg.GetHourlyPrices(),
+		QOS:                    &types.QOS{MaxPeers: n.cfg.MaxPeers},
+		Type:                   n.cfg.GetServiceType(),
+	}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		info.Version = bi.Main.Version
+	}
+
+	// Resolve the location of the public IP address of the node.
+	n.log.Info("Resolving the location of the node")
+	location, err := geoip.NewDefaultClient().Get("")
+	if err != nil {
+		n.log.Error("Failed to resolve the location of the node", "error", err)
+	} else {
+		info.Location = location
+	}
+
+	// Measure the bandwidth of the node.
+	n.log.Info("Measuring the bandwidth of the node")
+	dlSpeed, ulSpeed, err := speedtest.Run()
+	if err != nil {
+		n.log.Error("Failed to measure the bandwidth of the node", "error", err)
+	} else {
+		info.Bandwidth = &types.Bandwidth{
+			Download: dlSpeed.Int64(),
+			Upload:   ulSpeed.Int64(),
+		}
+	}
+
+	return info
+}
+
+// broadcastTx broadcasts the messages signed by the node key.
+func (n *Node) broadcastTx(ctx context.Context, msgs ...sdk.Msg) error {
+	res, err := n.c.BroadcastTx(ctx, msgs, n.opts)
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return fmt.Errorf("transaction %s failed with code %d: %s", res.Hash, res.Code, res.Log)
+	}
+
+	n.log.Info("Broadcast transaction", "hash", res.Hash, "msgs", len(msgs))
+	return nil
+}
+
+// up brings the service up.
+func (n *Node) up(ctx context.Context) error {
+	if err := n.service.PreUp(n.serverOptions()); err != nil {
+		return err
+	}
+	if err := n.service.Up(ctx); err != nil {
+		return err
+	}
+
+	return n.service.PostUp()
+}
+
+// down brings the service down.
+func (n *Node) down(ctx context.Context) error {
+	if err := n.service.PreDown(); err != nil {
+		return err
+	}
+	if err := n.service.Down(ctx); err != nil {
+		return err
+	}
+
+	return n.service.PostDown()
+}
+
+// Run runs the node until the context is canceled, then shuts it down gracefully: the node API stops
+// accepting requests, the workers are stopped, the node is marked inactive and the service is brought down.
+func (n *Node) Run(ctx context.Context) (err error) {
+	n.info = n.newInfo()
+
+	// Bring the service up, and down again when the node stops.
+	n.log.Info("Bringing the service up", "type", n.cfg.ServiceType)
+	if err := n.up(ctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		n.log.Info("Bringing the service down")
+		if derr := n.down(context.Background()); derr != nil {
+			err = errors.Join(err, derr)
+		}
+	}()
+
+	// Register and start the workers keeping the chain updated.
+	scheduler := cron.NewScheduler()
+	if err := scheduler.RegisterWorkers(
+		n.newStatusWorker(ctx),
+		n.newSessionsWorker(ctx),
+	); err != nil {
+		return err
+	}
+	if err := scheduler.Start(); err != nil {
+		return err
+	}
+
+	defer scheduler.Stop()
+
+	// Serve the node API.
+	server := &http.Server{
+		Addr:              n.cfg.APIListenAddr,
+		Handler:           n.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		n.log.Info("Serving the node API", "addr", n.cfg.APIListenAddr)
+		if n.cfg.APITLSCertPath != "" {
+			errCh <- server.ListenAndServeTLS(n.cfg.APITLSCertPath, n.cfg.APITLSKeyPath)
+		} else {
+			errCh <- server.ListenAndServe()
+		}
+	}()
+
+	// Wait for a stop signal or a failure of the node API.
+	select {
+	case <-ctx.Done():
+		n.log.Info("Shutting down the node")
+	case err := <-errCh:
+		return fmt.Errorf("node API failed: %w", err)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		n.log.Error("Failed to shut down the node API", "error", err)
+	}
+
+	// Mark the node inactive, so clients stop starting sessions on it.
+	msg := v3nodetypes.NewMsgUpdateNodeStatusRequest(n.addr, v1base.StatusInactive)
+	if err := n.broadcastTx(shutdownCtx, msg); err != nil {
+		n.log.Error("Failed to mark the node inactive", "error", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package node
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+	"github.com/sentinel-official/sentinel-go-sdk/v2ray"
+	"github.com/sentinel-official/sentinel-go-sdk/wireguard"
+)
+
+// addPeerRequest decodes the handshake data of the service type into a request adding the peer,
+// and returns it with the key identifying the peer in the service.
+func addPeerRequest(t types.ServiceType, data []byte) (interface{}, string, error) {
+	switch t {
+	case types.ServiceTypeWireGuard:
+		// The data is the public key of the client.
+		if len(data) != wireguard.KeyLength {
+			return nil, "", fmt.Errorf("data must be a %d bytes public key", wireguard.KeyLength)
+		}
+
+		key := wireguard.Key(data)
+		req := &wireguard.AddPeerRequest{
+			PublicKey: &key,
+		}
+
+		return req, req.Key(), nil
+	case types.ServiceTypeV2Ray:
+		// The data is the encoded peer request of the client.
+		req, err := v2ray.NewAddPeerRequestFromBytes(data)
+		if err != nil {
+			return nil, "", err
+		}
+		if err := req.Validate(); err != nil {
+			return nil, "", err
+		}
+
+		return req, req.Key(), nil
+	default:
+		return nil, "", fmt.Errorf("unsupported service type %d", t)
+	}
+}
+
+// removePeerRequest returns a request removing the peer with the given key from the service.
+func removePeerRequest(t types.ServiceType, key string) (interface{}, error) {
+	switch t {
+	case types.ServiceTypeWireGuard:
+		return wireguard.NewRemovePeerRequestFromKey(key)
+	case types.ServiceTypeV2Ray:
+		return v2ray.NewRemovePeerRequestFromKey(key)
+	default:
+		return nil, fmt.Errorf("unsupported service type %d", t)
+	}
+}
+
+// handshakeData returns the handshake result data from the result of adding the peer to the service.
+// For WireGuard, the assigned IPv4 and IPv6 addresses are followed by the public key and listen port of the server.
+// For V2Ray, the data is the port of the inbound matching the protocol, network and security of the request.
+func (n *Node) handshakeData(req interface{}, res []byte) ([]byte, error) {
+	switch n.cfg.GetServiceType() {
+	case types.ServiceTypeWireGuard:
+		key, err := wireguard.NewKeyFromString(n.cfg.WireGuard.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, key.Public()[:]...)
+		return binary.BigEndian.AppendUint16(res, n.cfg.WireGuard.ListenPort), nil
+	case types.ServiceTypeV2Ray:
+		tag := req.(*v2ray.AddPeerRequest).Tag()
+
+		inbound := n.cfg.V2Ray.Inbound(tag)
+		if inbound == nil {
+			return nil, fmt.Errorf("inbound %s not found", tag)
+		}
+
+		return binary.BigEndian.AppendUint16(res, inbound.Port), nil
+	default:
+		return res, nil
+	}
+}
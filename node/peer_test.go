@@ -0,0 +1,44 @@
+package node
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+	"github.com/sentinel-official/sentinel-go-sdk/v2ray"
+)
+
+func TestNode_handshakeData_v2Ray(t *testing.T) {
+	n := newTestNode(t, &fakeChain{}, &fakeService{})
+	n.cfg.ServiceType = types.ServiceTypeV2Ray.String()
+	n.cfg.V2Ray = (&v2ray.ServerOptions{}).WithInbounds(
+		(&v2ray.InboundServerOptions{}).WithNetwork("tcp").WithPort(8080).WithProtocol("vmess").WithSecurity("none"),
+		(&v2ray.InboundServerOptions{}).WithNetwork("grpc").WithPort(8443).WithProtocol("vless").WithSecurity("tls"),
+	)
+
+	tests := []struct {
+		name    string
+		req     *v2ray.AddPeerRequest
+		want    uint16
+		wantErr bool
+	}{
+		{"vmess inbound", &v2ray.AddPeerRequest{Protocol: v2ray.ProtocolVMess, Network: v2ray.NetworkTCP, Security: v2ray.SecurityNone}, 8080, false},
+		{"vless inbound", &v2ray.AddPeerRequest{Protocol: v2ray.ProtocolVLess, Network: v2ray.NetworkGRPC, Security: v2ray.SecurityTLS}, 8443, false},
+		{"no matching inbound", &v2ray.AddPeerRequest{Protocol: v2ray.ProtocolVMess, Network: v2ray.NetworkTCP, Security: v2ray.SecurityTLS}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := n.handshakeData(tt.req, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handshakeData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != 2 || binary.BigEndian.Uint16(got) != tt.want {
+				t.Errorf("handshakeData() = %x, want port %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package node
+
+import (
+	"context"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	v3nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v3"
+	sessiontypes "github.com/sentinel-official/hub/v12/x/session/types/v3"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/libs/cron"
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+)
+
+// Names of the workers of the node.
+const (
+	workerUpdateSessions = "update_sessions"
+	workerUpdateStatus   = "update_status"
+)
+
+// onWorkerError returns an error handler logging the errors of the named worker without stopping it.
+func (n *Node) onWorkerError(name string) func(error) bool {
+	return func(err error) bool {
+		n.log.Error("Worker failed", "name", name, "error", err)
+		return false
+	}
+}
+
+// newStatusWorker returns a worker marking the node active on the chain at every status interval.
+func (n *Node) newStatusWorker(ctx context.Context) cron.Worker {
+	handler := func() error {
+		msg := v3nodetypes.NewMsgUpdateNodeStatusRequest(n.addr, v1base.StatusActive)
+		return n.broadcastTx(ctx, msg)
+	}
+
+	return cron.NewBasicWorker().
+		WithHandler(handler).
+		WithInterval(n.cfg.GetIntervalUpdateStatus()).
+		WithName(workerUpdateStatus).
+		WithOnError(n.onWorkerError(workerUpdateStatus))
+}
+
+// newSessionsWorker returns a worker that, at every session interval, removes the peers of the sessions
+// that are no longer active and updates the bandwidth and duration of the others on the chain.
+func (n *Node) newSessionsWorker(ctx context.Context) cron.Worker {
+	handler := func() error {
+		items, err := n.service.PeerStatistics(ctx)
+		if err != nil {
+			return err
+		}
+
+		stats := make(map[string]*types.PeerStatistic, len(items))
+		for _, item := range items {
+			stats[item.Key] = item
+		}
+
+		n.mu.Lock()
+		peers := make([]*peer, 0, len(n.peers))
+		for _, item := range n.peers {
+			peers = append(peers, item)
+		}
+		n.mu.Unlock()
+
+		var msgs []sdk.Msg
+		for _, item := range peers {
+			session, err := n.c.Session(ctx, item.ID, n.opts)
+			if err != nil && !client.IsNotFound(err) {
+				return err
+			}
+
+			// Remove the peer of a session that ended.
+			if session == nil || !session.GetStatus().Equal(v1base.StatusActive) {
+				if err := n.removePeer(ctx, item); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			stat, ok := stats[item.Key]
+			if !ok {
+				continue
+			}
+
+			msgs = append(msgs, sessiontypes.NewMsgUpdateSessionRequest(
+				n.addr,
+				item.ID,
+				sdkmath.NewInt(stat.DownloadBytes),
+				sdkmath.NewInt(stat.UploadBytes),
+				time.Since(item.StartedAt),
+				nil,
+			))
+		}
+
+		if len(msgs) == 0 {
+			return nil
+		}
+
+		return n.broadcastTx(ctx, msgs...)
+	}
+
+	return cron.NewBasicWorker().
+		WithHandler(handler).
+		WithInterval(n.cfg.GetIntervalUpdateSessions()).
+		WithName(workerUpdateSessions).
+		WithOnError(n.onWorkerError(workerUpdateSessions))
+}
+
+// removePeer removes the peer from the service and forgets its session.
+func (n *Node) removePeer(ctx context.Context, p *peer) error {
+	req, err := removePeerRequest(n.cfg.GetServiceType(), p.Key)
+	if err != nil {
+		return err
+	}
+	if err := n.service.RemovePeer(ctx, req); err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	delete(n.peers, p.ID)
+	n.mu.Unlock()
+
+	n.log.Info("Removed peer", "id", p.ID)
+	return nil
+}
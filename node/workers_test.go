@@ -0,0 +1,115 @@
+package node
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	v3nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v3"
+	sessiontypes "github.com/sentinel-official/hub/v12/x/session/types/v3"
+	subscriptiontypes "github.com/sentinel-official/hub/v12/x/subscription/types/v3"
+
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+	"github.com/sentinel-official/sentinel-go-sdk/wireguard"
+)
+
+// addTestPeer adds a peer with a new WireGuard public key for the session to the node and its service.
+func addTestPeer(t *testing.T, n *Node, service *fakeService, id uint64) *peer {
+	key, err := wireguard.NewPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &peer{
+		ID:        id,
+		Key:       key.Public().String(),
+		StartedAt: time.Now().Add(-time.Hour),
+	}
+
+	n.peers[id] = p
+	service.peers[p.Key] = true
+	return p
+}
+
+func TestNode_newStatusWorker(t *testing.T) {
+	c := &fakeChain{}
+	n := newTestNode(t, c, &fakeService{peers: make(map[string]bool)})
+
+	w := n.newStatusWorker(context.Background())
+	if w.Interval() != n.cfg.GetIntervalUpdateStatus() {
+		t.Errorf("Interval() = %s, want %s", w.Interval(), n.cfg.GetIntervalUpdateStatus())
+	}
+	if err := w.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	want := []sdk.Msg{v3nodetypes.NewMsgUpdateNodeStatusRequest(testNodeAddr, v1base.StatusActive)}
+	if !reflect.DeepEqual(c.msgs, want) {
+		t.Errorf("msgs = %v, want %v", c.msgs, want)
+	}
+}
+
+func TestNode_newSessionsWorker(t *testing.T) {
+	accAddr := sdk.AccAddress([]byte("test-acc-address-000"))
+
+	c := &fakeChain{sessions: map[uint64]*subscriptiontypes.Session{
+		1: newTestSession(1, accAddr, testNodeAddr, v1base.StatusActive),
+		2: newTestSession(2, accAddr, testNodeAddr, v1base.StatusInactivePending),
+		4: newTestSession(4, accAddr, testNodeAddr, v1base.StatusActive),
+	}}
+	service := &fakeService{peers: make(map[string]bool)}
+	n := newTestNode(t, c, service)
+
+	// The session of peer 2 ended, and the session of peer 3 no longer exists.
+	active := addTestPeer(t, n, service, 1)
+	addTestPeer(t, n, service, 2)
+	addTestPeer(t, n, service, 3)
+	idle := addTestPeer(t, n, service, 4)
+
+	// Only the peer of session 1 has statistics.
+	service.stats = []*types.PeerStatistic{{Key: active.Key, DownloadBytes: 1000, UploadBytes: 500}}
+
+	w := n.newSessionsWorker(context.Background())
+	if err := w.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if got, want := peerIDs(n), []uint64{1, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("peers = %v, want %v", got, want)
+	}
+	if want := map[string]bool{active.Key: true, idle.Key: true}; !reflect.DeepEqual(service.peers, want) {
+		t.Errorf("service peers = %v, want %v", service.peers, want)
+	}
+
+	// The session with statistics is updated on the chain.
+	if len(c.msgs) != 1 {
+		t.Fatalf("msgs = %v, want one session update", c.msgs)
+	}
+
+	msg, ok := c.msgs[0].(*sessiontypes.MsgUpdateSessionRequest)
+	if !ok || msg.ID != 1 || msg.DownloadBytes.Int64() != 1000 || msg.UploadBytes.Int64() != 500 || msg.Duration < time.Hour {
+		t.Errorf("msg = %+v, want the statistics and duration of session 1", c.msgs[0])
+	}
+}
+
+func TestNode_newSessionsWorker_removePeerFailure(t *testing.T) {
+	c := &fakeChain{}
+	service := &fakeService{
+		errs:  map[string]error{"RemovePeer": context.Canceled},
+		peers: make(map[string]bool),
+	}
+	n := newTestNode(t, c, service)
+	addTestPeer(t, n, service, 1)
+
+	// A peer that cannot be removed is kept, to be removed by the next run.
+	w := n.newSessionsWorker(context.Background())
+	if err := w.Run(); err == nil {
+		t.Fatal("Run() succeeded, want the remove peer error")
+	}
+	if got, want := peerIDs(n), []uint64{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("peers = %v, want %v", got, want)
+	}
+}
@@ -3,29 +3,38 @@ package options
 import (
 	"errors"
 	"io"
+	"os"
+	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/spf13/cobra"
 
+	"github.com/sentinel-official/sentinel-go-sdk/client/input"
 	"github.com/sentinel-official/sentinel-go-sdk/flags"
+	"github.com/sentinel-official/sentinel-go-sdk/libs/signer"
 )
 
 // Keyring represents options for keyring creation.
 type Keyring struct {
-	Input io.Reader // Input is the source of passphrase input.
+	Input    io.Reader      // Input is the source of passphrase input.
+	Prompter input.Prompter // Prompter prompts for passphrases, taking precedence over Input when set.
 
-	AppName string `json:"app_name" toml:"app_name"` // AppName is the name of the application.
-	Backend string `json:"backend" toml:"backend"`   // Backend specifies the keyring backend to use.
-	HomeDir string `json:"home_dir" toml:"home_dir"` // HomeDir is the directory where keys are stored.
+	AppName         string `json:"app_name" toml:"app_name"`                   // AppName is the name of the application.
+	Backend         string `json:"backend" toml:"backend"`                     // Backend specifies the keyring backend to use.
+	HomeDir         string `json:"home_dir" toml:"home_dir"`                   // HomeDir is the directory where keys are stored.
+	RemoteAddr      string `json:"remote_addr" toml:"remote_addr"`             // RemoteAddr is the address of the signer used by the remote backend.
+	RemoteTokenFile string `json:"remote_token_file" toml:"remote_token_file"` // RemoteTokenFile is the file holding the token presented to the signer.
 }
 
 // NewKeyring creates a new Keyring instance with default values.
 func NewKeyring() *Keyring {
 	return &Keyring{
-		AppName: flags.DefaultKeyringAppName,
-		Backend: flags.DefaultKeyringBackend,
-		HomeDir: flags.DefaultKeyringHomeDir,
+		AppName:         flags.DefaultKeyringAppName,
+		Backend:         flags.DefaultKeyringBackend,
+		HomeDir:         flags.DefaultKeyringHomeDir,
+		RemoteAddr:      flags.DefaultKeyringRemoteAddr,
+		RemoteTokenFile: flags.DefaultKeyringRemoteTokenFile,
 	}
 }
 
@@ -47,12 +56,24 @@ func (k *Keyring) WithHomeDir(v string) *Keyring {
 	return k
 }
 
+// WithRemoteAddr sets the RemoteAddr field and returns the updated Keyring instance.
+func (k *Keyring) WithRemoteAddr(v string) *Keyring {
+	k.RemoteAddr = v
+	return k
+}
+
 // WithInput sets the Input field and returns the updated Keyring instance.
 func (k *Keyring) WithInput(v io.Reader) *Keyring {
 	k.Input = v
 	return k
 }
 
+// WithPrompter sets the Prompter field and returns the updated Keyring instance.
+func (k *Keyring) WithPrompter(v input.Prompter) *Keyring {
+	k.Prompter = v
+	return k
+}
+
 // GetAppName returns the application name.
 func (k *Keyring) GetAppName() string {
 	return k.AppName
@@ -68,11 +89,34 @@ func (k *Keyring) GetHomeDir() string {
 	return k.HomeDir
 }
 
+// GetRemoteAddr returns the address of the remote signer.
+func (k *Keyring) GetRemoteAddr() string {
+	return k.RemoteAddr
+}
+
+// GetRemoteTokenFile returns the file holding the token presented to the remote signer.
+func (k *Keyring) GetRemoteTokenFile() string {
+	return k.RemoteTokenFile
+}
+
 // GetInput returns the input source for the passphrase.
 func (k *Keyring) GetInput() io.Reader {
 	return k.Input
 }
 
+// GetPrompter returns the prompter for passphrases and other user input. Without a Prompter,
+// it returns a terminal prompter reading from Input, or from the standard input if Input is not set.
+func (k *Keyring) GetPrompter() input.Prompter {
+	if k.Prompter != nil {
+		return k.Prompter
+	}
+	if k.Input != nil {
+		return input.NewTerminalPrompter(k.Input, os.Stderr)
+	}
+
+	return input.NewTerminalPrompter(os.Stdin, os.Stderr)
+}
+
 // ValidateKeyringAppName checks if the AppName field is valid.
 func ValidateKeyringAppName(v string) error {
 	if v == "" {
@@ -90,6 +134,7 @@ func ValidateKeyringBackend(v string) error {
 		"memory":  true,
 		"os":      true,
 		"pass":    true,
+		"remote":  true,
 		"test":    true,
 	}
 
@@ -97,7 +142,7 @@ func ValidateKeyringBackend(v string) error {
 		return errors.New("backend must be non-empty")
 	}
 	if _, ok := allowedBackends[v]; !ok {
-		return errors.New("backend must be one of: file, kwallet, memory, os, pass, test")
+		return errors.New("backend must be one of: file, kwallet, memory, os, pass, remote, test")
 	}
 
 	return nil
@@ -112,6 +157,34 @@ func ValidateKeyringHomeDir(v string) error {
 	return nil
 }
 
+// ValidateKeyringRemoteAddr checks if the RemoteAddr field is valid for the given backend.
+func ValidateKeyringRemoteAddr(backend, v string) error {
+	if backend != signer.BackendRemote {
+		return nil
+	}
+	if v == "" {
+		return errors.New("remote address must be non-empty for the remote backend")
+	}
+	if _, _, err := signer.ParseAddr(v); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateKeyringRemoteTokenFile checks if the RemoteTokenFile field is valid for the given backend and remote address.
+// A signer listening on a tcp address requires a token.
+func ValidateKeyringRemoteTokenFile(backend, remoteAddr, v string) error {
+	if backend != signer.BackendRemote || v != "" {
+		return nil
+	}
+	if network, _, err := signer.ParseAddr(remoteAddr); err == nil && network == "tcp" {
+		return errors.New("remote token file must be non-empty for a tcp remote address")
+	}
+
+	return nil
+}
+
 // Validate validates all fields of the Keyring struct.
 func (k *Keyring) Validate() error {
 	if err := ValidateKeyringAppName(k.AppName); err != nil {
@@ -123,13 +196,66 @@ func (k *Keyring) Validate() error {
 	if err := ValidateKeyringHomeDir(k.HomeDir); err != nil {
 		return err
 	}
+	if err := ValidateKeyringRemoteAddr(k.Backend, k.RemoteAddr); err != nil {
+		return err
+	}
+	if err := ValidateKeyringRemoteTokenFile(k.Backend, k.RemoteAddr, k.RemoteTokenFile); err != nil {
+		return err
+	}
 
 	return nil
 }
 
 // Keystore creates and returns a new keyring based on the provided options.
+// The remote backend returns a keyring that delegates signing to the signer at RemoteAddr,
+// presenting the token read from RemoteTokenFile if it is set.
+// Passphrase-protected backends prompt with the Prompter if it is set, and read from Input otherwise.
 func (k *Keyring) Keystore(cdc codec.Codec) (keyring.Keyring, error) {
-	return keyring.New(k.GetAppName(), k.GetBackend(), k.GetHomeDir(), k.GetInput(), cdc)
+	if k.GetBackend() == signer.BackendRemote {
+		kr, err := signer.NewKeyring(k.GetRemoteAddr(), cdc)
+		if err != nil {
+			return nil, err
+		}
+		if k.GetRemoteTokenFile() == "" {
+			return kr, nil
+		}
+
+		token, err := signer.ReadTokenFile(k.GetRemoteTokenFile())
+		if err != nil {
+			return nil, err
+		}
+
+		return kr.WithToken(token), nil
+	}
+
+	userInput := k.GetInput()
+	if k.Prompter != nil {
+		userInput = input.NewPasswordReader(k.Prompter, k.passphrasePrompts()...)
+	}
+
+	return keyring.New(k.GetAppName(), k.GetBackend(), k.GetHomeDir(), userInput, cdc)
+}
+
+// passphrasePrompts returns the prompts of the passphrase reads of the keyring backend. A new file keyring
+// reads the passphrase and then its confirmation, on each attempt.
+func (k *Keyring) passphrasePrompts() []string {
+	prompts := []string{"Enter keyring passphrase:"}
+	if k.GetBackend() != keyring.BackendFile {
+		return prompts
+	}
+
+	// The file backend stores the hash of the passphrase once the keyring is created.
+	if _, err := os.Stat(filepath.Join(k.GetHomeDir(), "keyring-file", "keyhash")); errors.Is(err, os.ErrNotExist) {
+		prompts = append(prompts, "Re-enter keyring passphrase:")
+	}
+
+	return prompts
+}
+
+// WithRemoteTokenFile sets the RemoteTokenFile field and returns the updated Keyring instance.
+func (k *Keyring) WithRemoteTokenFile(v string) *Keyring {
+	k.RemoteTokenFile = v
+	return k
 }
 
 // NewKeyringFromCmd creates and returns a Keyring from the given cobra command's flags.
@@ -152,11 +278,26 @@ func NewKeyringFromCmd(cmd *cobra.Command) (*Keyring, error) {
 		return nil, err
 	}
 
+	// Retrieve the remote signer address flag value from the command.
+	remoteAddr, err := flags.GetKeyringRemoteAddr(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve the remote signer token file flag value from the command.
+	remoteTokenFile, err := flags.GetKeyringRemoteTokenFile(cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return a new Keyring instance populated with the retrieved flag values.
 	return &Keyring{
-		AppName: appName,
-		Backend: backend,
-		HomeDir: homeDir,
-		Input:   cmd.InOrStdin(), // Use the command's input or standard input as the passphrase source.
+		AppName:         appName,
+		Backend:         backend,
+		HomeDir:         homeDir,
+		RemoteAddr:      remoteAddr,
+		RemoteTokenFile: remoteTokenFile,
+		Input:           cmd.InOrStdin(), // Use the command's input or standard input as the passphrase source.
+		Prompter:        input.NewTerminalPrompter(cmd.InOrStdin(), cmd.ErrOrStderr()),
 	}, nil
 }
@@ -2,7 +2,9 @@ package options
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
 	"time"
 
@@ -16,12 +18,13 @@ import (
 
 // Query represents options for making queries.
 type Query struct {
-	Height     int64  `json:"height" toml:"height"`           // Height is the block height at which the query is to be performed.
-	MaxRetries int    `json:"max_retries" toml:"max_retries"` // MaxRetries is the maximum number of retries for the query.
-	Prove      bool   `json:"prove" toml:"prove"`             // Prove indicates whether to include proof in query results.
-	RetryDelay string `json:"retry_delay" toml:"retry_delay"` // RetryDelay is the delay between query retries.
-	RPCAddr    string `json:"rpc_addr" toml:"rpc_addr"`       // RPCAddr is the address of the RPC server.
-	Timeout    string `json:"timeout" toml:"timeout"`         // Timeout is the maximum duration for the query to be executed.
+	Height     int64    `json:"height" toml:"height"`           // Height is the block height at which the query is to be performed.
+	MaxRetries int      `json:"max_retries" toml:"max_retries"` // MaxRetries is the maximum number of retries for the query.
+	Prove      bool     `json:"prove" toml:"prove"`             // Prove indicates whether to include proof in query results.
+	RetryDelay string   `json:"retry_delay" toml:"retry_delay"` // RetryDelay is the delay between query retries.
+	RPCAddr    string   `json:"rpc_addr" toml:"rpc_addr"`       // RPCAddr is the address of the RPC server.
+	RPCAddrs   []string `json:"rpc_addrs" toml:"rpc_addrs"`     // RPCAddrs are the addresses of fallback RPC servers, queried in order when the RPC server fails.
+	Timeout    string   `json:"timeout" toml:"timeout"`         // Timeout is the maximum duration for the query to be executed.
 }
 
 // NewQuery creates a new Query instance with default values.
@@ -32,6 +35,7 @@ func NewQuery() *Query {
 		Prove:      flags.DefaultQueryProve,
 		RetryDelay: flags.DefaultQueryRetryDelay,
 		RPCAddr:    flags.DefaultQueryRPCAddr,
+		RPCAddrs:   flags.DefaultQueryRPCAddrs,
 		Timeout:    flags.DefaultQueryTimeout,
 	}
 }
@@ -66,6 +70,12 @@ func (q *Query) WithRPCAddr(v string) *Query {
 	return q
 }
 
+// WithRPCAddrs sets the RPCAddrs field and returns the modified Query instance.
+func (q *Query) WithRPCAddrs(v ...string) *Query {
+	q.RPCAddrs = v
+	return q
+}
+
 // WithTimeout sets the Timeout field and returns the modified Query instance.
 func (q *Query) WithTimeout(v time.Duration) *Query {
 	q.Timeout = v.String()
@@ -102,6 +112,19 @@ func (q *Query) GetRPCAddr() string {
 	return q.RPCAddr
 }
 
+// GetRPCAddrs returns the address of the RPC server followed by the addresses of the fallback RPC servers,
+// without duplicates.
+func (q *Query) GetRPCAddrs() []string {
+	addrs := []string{q.RPCAddr}
+	for _, addr := range q.RPCAddrs {
+		if !slices.Contains(addrs, addr) {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return addrs
+}
+
 // GetTimeout returns the maximum duration for the query.
 func (q *Query) GetTimeout() time.Duration {
 	v, err := time.ParseDuration(q.Timeout)
@@ -174,6 +197,17 @@ func ValidateQueryRPCAddr(v string) error {
 	return nil
 }
 
+// ValidateQueryRPCAddrs validates the RPCAddrs field.
+func ValidateQueryRPCAddrs(v []string) error {
+	for _, addr := range v {
+		if err := ValidateQueryRPCAddr(addr); err != nil {
+			return fmt.Errorf("invalid rpc_addrs: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // ValidateQueryTimeout validates the Timeout field.
 func ValidateQueryTimeout(v string) error {
 	duration, err := time.ParseDuration(v)
@@ -201,6 +235,9 @@ func (q *Query) Validate() error {
 	if err := ValidateQueryRPCAddr(q.RPCAddr); err != nil {
 		return err
 	}
+	if err := ValidateQueryRPCAddrs(q.RPCAddrs); err != nil {
+		return err
+	}
 	if err := ValidateQueryTimeout(q.Timeout); err != nil {
 		return err
 	}
@@ -216,10 +253,15 @@ func (q *Query) ABCIQueryOptions() client.ABCIQueryOptions {
 	}
 }
 
-// Client creates a new HTTP client with the configured options.
+// Client creates a new HTTP client of the RPC server with the configured options.
 func (q *Query) Client() (*http.HTTP, error) {
+	return q.ClientFor(q.GetRPCAddr())
+}
+
+// ClientFor creates a new HTTP client of the RPC server at the given address with the configured options.
+func (q *Query) ClientFor(addr string) (*http.HTTP, error) {
 	timeout := utils.UIntSecondsFromDuration(q.GetTimeout())
-	return http.NewWithTimeout(q.GetRPCAddr(), "/websocket", timeout)
+	return http.NewWithTimeout(addr, "/websocket", timeout)
 }
 
 // NewQueryFromCmd creates and returns Query from the given cobra command's flags.
@@ -254,6 +296,12 @@ func NewQueryFromCmd(cmd *cobra.Command) (*Query, error) {
 		return nil, err
 	}
 
+	// Retrieve the fallback RPC addresses flag value from the command.
+	rpcAddrs, err := flags.GetQueryRPCAddrs(cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	// Retrieve the timeout flag value from the command.
 	timeout, err := flags.GetQueryTimeout(cmd)
 	if err != nil {
@@ -267,6 +315,7 @@ func NewQueryFromCmd(cmd *cobra.Command) (*Query, error) {
 		Prove:      prove,
 		RetryDelay: retryDelay,
 		RPCAddr:    rpcAddr,
+		RPCAddrs:   rpcAddrs,
 		Timeout:    timeout,
 	}, nil
 }
@@ -6,6 +6,7 @@ import (
 	cosmossdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
+	"github.com/sentinel-official/sentinel-go-sdk/denoms"
 	"github.com/sentinel-official/sentinel-go-sdk/flags"
 )
 
@@ -118,9 +119,9 @@ func (t *Tx) GetFeeGranterAddr() cosmossdk.AccAddress {
 	return v
 }
 
-// GetFees returns the Fees field.
+// GetFees returns the Fees field, converting amounts in display units such as "0.5dvpn" to base units.
 func (t *Tx) GetFees() cosmossdk.Coins {
-	v, err := cosmossdk.ParseCoinsNormalized(t.Fees)
+	v, err := denoms.DefaultRegistry.ParseCoins(t.Fees)
 	if err != nil {
 		panic(err)
 	}
@@ -143,9 +144,9 @@ func (t *Tx) GetGasAdjustment() float64 {
 	return t.GasAdjustment
 }
 
-// GetGasPrices returns the GasPrices field.
+// GetGasPrices returns the GasPrices field, converting amounts in display units to base units.
 func (t *Tx) GetGasPrices() cosmossdk.DecCoins {
-	v, err := cosmossdk.ParseDecCoins(t.GasPrices)
+	v, err := denoms.DefaultRegistry.ParseDecCoins(t.GasPrices)
 	if err != nil {
 		panic(err)
 	}
@@ -191,7 +192,7 @@ func ValidateTxFeeGranterAddr(v string) error {
 
 // ValidateTxFees validates the Fees field.
 func ValidateTxFees(v string) error {
-	if _, err := cosmossdk.ParseCoinsNormalized(v); err != nil {
+	if _, err := denoms.DefaultRegistry.ParseCoins(v); err != nil {
 		return errors.New("fees must be a valid coins format")
 	}
 
@@ -227,7 +228,7 @@ func ValidateTxGasAdjustment(v float64) error {
 
 // ValidateTxGasPrices validates the GasPrices field.
 func ValidateTxGasPrices(v string) error {
-	if _, err := cosmossdk.ParseDecCoins(v); err != nil {
+	if _, err := denoms.DefaultRegistry.ParseDecCoins(v); err != nil {
 		return errors.New("gas_prices must be a valid decimal coins format")
 	}
 
@@ -0,0 +1,122 @@
+package pricing
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v2"
+	v3nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v3"
+	plantypes "github.com/sentinel-official/hub/v12/x/plan/types/v2"
+	v3subscriptiontypes "github.com/sentinel-official/hub/v12/x/subscription/types/v3"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+)
+
+// Calculator estimates the cost of sessions and subscriptions for an account.
+type Calculator struct {
+	c *client.Client // Client used to query balances and simulate transactions.
+}
+
+// NewCalculator creates a new Calculator using the given client.
+func NewCalculator(c *client.Client) *Calculator {
+	return &Calculator{
+		c: c,
+	}
+}
+
+// estimate completes the estimate with the simulated fee and the affordability check for the given message.
+func (c *Calculator) estimate(ctx context.Context, accAddr sdk.AccAddress, msg sdk.Msg, res *Estimate, opts *client.Options) (*Estimate, error) {
+	// Estimate the transaction fee by simulating the message.
+	fee, err := c.c.EstimateFees(ctx, []sdk.Msg{msg}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// The fee is not paid by the account when a fee granter is set.
+	res.Fee = fee
+	res.FeeGranted = opts.GetFeeGranterAddr() != nil
+	res.Total = sdk.NewCoins(res.Deposit)
+	if !res.FeeGranted {
+		res.Total = res.Total.Add(fee...)
+	}
+
+	// Query the balance of the account for each denom of the total.
+	res.Balance = sdk.NewCoins()
+	for _, coin := range res.Total {
+		balance, err := c.c.Balance(ctx, accAddr, coin.Denom, opts)
+		if err != nil {
+			return nil, err
+		}
+		if balance != nil {
+			res.Balance = res.Balance.Add(*balance)
+		}
+	}
+
+	res.Affordable = res.Balance.IsAllGTE(res.Total)
+
+	return res, nil
+}
+
+// ForNode estimates the cost of starting a session on the node for the requested number of
+// gigabytes or hours, priced in the given denom, for the account of the key in the options.
+func (c *Calculator) ForNode(ctx context.Context, node *nodetypes.Node, denom string, gigabytes, hours int64, opts *client.Options) (*Estimate, error) {
+	// Calculate the deposit locked for the session.
+	deposit, err := NodeDeposit(node, denom, gigabytes, hours)
+	if err != nil {
+		return nil, err
+	}
+
+	accAddr, err := c.c.FromAddr(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message that would start the session.
+	msg := &v3nodetypes.MsgStartSessionRequest{
+		From:        accAddr.String(),
+		NodeAddress: node.Address,
+		Gigabytes:   gigabytes,
+		Hours:       hours,
+		Denom:       denom,
+	}
+
+	res := &Estimate{
+		Denom:     denom,
+		Gigabytes: gigabytes,
+		Hours:     hours,
+		Deposit:   deposit,
+	}
+
+	return c.estimate(ctx, accAddr, msg, res, opts)
+}
+
+// ForPlan estimates the cost of subscribing to the plan, priced in the given denom,
+// for the account of the key in the options.
+func (c *Calculator) ForPlan(ctx context.Context, plan *plantypes.Plan, denom string, opts *client.Options) (*Estimate, error) {
+	// Calculate the deposit paid for the subscription.
+	deposit, err := PlanDeposit(plan, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	accAddr, err := c.c.FromAddr(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message that would start the subscription.
+	msg := &v3subscriptiontypes.MsgStartSubscriptionRequest{
+		From:  accAddr.String(),
+		ID:    plan.ID,
+		Denom: denom,
+	}
+
+	res := &Estimate{
+		Denom:     denom,
+		Gigabytes: plan.Gigabytes,
+		Hours:     int64(plan.Duration.Hours()),
+		Deposit:   deposit,
+	}
+
+	return c.estimate(ctx, accAddr, msg, res, opts)
+}
@@ -0,0 +1,92 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v2"
+	plantypes "github.com/sentinel-official/hub/v12/x/plan/types/v2"
+)
+
+// Estimate represents the cost of starting a session or subscription.
+type Estimate struct {
+	Denom      string    `json:"denom"`       // Denom is the denomination of the deposit.
+	Gigabytes  int64     `json:"gigabytes"`   // Gigabytes is the requested amount of data, if priced by gigabyte.
+	Hours      int64     `json:"hours"`       // Hours is the requested duration, if priced by hour.
+	Deposit    sdk.Coin  `json:"deposit"`     // Deposit is the amount locked for the session or subscription.
+	Fee        sdk.Coins `json:"fee"`         // Fee is the estimated transaction fee.
+	FeeGranted bool      `json:"fee_granted"` // FeeGranted indicates whether the fee is paid by a fee granter.
+	Total      sdk.Coins `json:"total"`       // Total is the amount the account has to pay.
+	Balance    sdk.Coins `json:"balance"`     // Balance is the balance of the account in the denoms of the total.
+	Affordable bool      `json:"affordable"`  // Affordable indicates whether the balance covers the total.
+}
+
+// Shortfall returns the amount missing from the balance to cover the total, if any.
+func (e *Estimate) Shortfall() sdk.Coins {
+	shortfall, _ := e.Total.SafeSub(e.Balance...)
+
+	res := sdk.NewCoins()
+	for _, coin := range shortfall {
+		if coin.IsPositive() {
+			res = res.Add(coin)
+		}
+	}
+
+	return res
+}
+
+// ValidateQuantity checks that exactly one of gigabytes or hours is positive.
+func ValidateQuantity(gigabytes, hours int64) error {
+	if gigabytes < 0 {
+		return errors.New("gigabytes must not be negative")
+	}
+	if hours < 0 {
+		return errors.New("hours must not be negative")
+	}
+	if gigabytes == 0 && hours == 0 {
+		return errors.New("either gigabytes or hours must be positive")
+	}
+	if gigabytes != 0 && hours != 0 {
+		return errors.New("only one of gigabytes or hours must be positive")
+	}
+
+	return nil
+}
+
+// NodeDeposit calculates the deposit required to start a session on the node for the
+// requested number of gigabytes or hours, priced in the given denom.
+func NodeDeposit(node *nodetypes.Node, denom string, gigabytes, hours int64) (sdk.Coin, error) {
+	if err := ValidateQuantity(gigabytes, hours); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// Select the price list and the quantity to charge for.
+	prices, quantity := node.GigabytePrices, gigabytes
+	if hours > 0 {
+		prices, quantity = node.HourlyPrices, hours
+	}
+
+	// Find the price in the requested denom.
+	found, price := prices.Find(denom)
+	if !found {
+		return sdk.Coin{}, fmt.Errorf("price for denom %s does not exist for node %s", denom, node.Address)
+	}
+
+	// Multiply the unit price by the requested quantity.
+	amount := price.Amount.Mul(sdkmath.NewInt(quantity))
+	return sdk.NewCoin(denom, amount), nil
+}
+
+// PlanDeposit calculates the deposit required to subscribe to the plan, priced in the given denom.
+// A plan is priced as a whole for its duration and gigabytes.
+func PlanDeposit(plan *plantypes.Plan, denom string) (sdk.Coin, error) {
+	// Find the price in the requested denom.
+	found, price := plan.Prices.Find(denom)
+	if !found {
+		return sdk.Coin{}, fmt.Errorf("price for denom %s does not exist for plan %d", denom, plan.ID)
+	}
+
+	return price, nil
+}
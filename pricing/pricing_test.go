@@ -0,0 +1,132 @@
+package pricing
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v2"
+	plantypes "github.com/sentinel-official/hub/v12/x/plan/types/v2"
+)
+
+func mustParseCoins(s string) sdk.Coins {
+	v, err := sdk.ParseCoinsNormalized(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func TestValidateQuantity(t *testing.T) {
+	tests := []struct {
+		name             string
+		gigabytes, hours int64
+		wantErr          bool
+	}{
+		{"gigabytes", 10, 0, false},
+		{"hours", 0, 5, false},
+		{"neither", 0, 0, true},
+		{"both", 10, 5, true},
+		{"negative gigabytes", -1, 0, true},
+		{"negative hours", 0, -1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateQuantity(tt.gigabytes, tt.hours); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateQuantity() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNodeDeposit(t *testing.T) {
+	node := &nodetypes.Node{
+		Address:        "sentnode1test",
+		GigabytePrices: mustParseCoins("100foo,200udvpn"),
+		HourlyPrices:   mustParseCoins("30udvpn"),
+	}
+
+	tests := []struct {
+		name             string
+		denom            string
+		gigabytes, hours int64
+		want             string
+		wantErr          bool
+	}{
+		{"gigabytes", "udvpn", 10, 0, "2000udvpn", false},
+		{"gigabytes in another denom", "foo", 3, 0, "300foo", false},
+		{"hours", "udvpn", 0, 4, "120udvpn", false},
+		{"hours without the denom", "foo", 0, 4, "", true},
+		{"unknown denom", "bar", 1, 0, "", true},
+		{"invalid quantity", "udvpn", 1, 1, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NodeDeposit(node, tt.denom, tt.gigabytes, tt.hours)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NodeDeposit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("NodeDeposit() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanDeposit(t *testing.T) {
+	plan := &plantypes.Plan{
+		ID:     1,
+		Prices: mustParseCoins("5000udvpn"),
+	}
+
+	tests := []struct {
+		name    string
+		denom   string
+		want    string
+		wantErr bool
+	}{
+		{"known denom", "udvpn", "5000udvpn", false},
+		{"unknown denom", "foo", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PlanDeposit(plan, tt.denom)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PlanDeposit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("PlanDeposit() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEstimate_Shortfall(t *testing.T) {
+	tests := []struct {
+		name    string
+		total   string
+		balance string
+		want    string
+	}{
+		{"affordable", "100udvpn", "150udvpn", ""},
+		{"exact", "100udvpn", "100udvpn", ""},
+		{"short", "100udvpn", "40udvpn", "60udvpn"},
+		{"missing denom", "10foo,100udvpn", "100udvpn", "10foo"},
+		{"empty balance", "100udvpn", "", "100udvpn"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Estimate{
+				Total:   mustParseCoins(tt.total),
+				Balance: mustParseCoins(tt.balance),
+			}
+			if got := e.Shortfall().String(); got != tt.want {
+				t.Errorf("Shortfall() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
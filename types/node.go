@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -40,3 +41,22 @@ type NodeInfo struct {
 	Type                   ServiceType      `json:"type"`
 	Version                string           `json:"version"`
 }
+
+// HandshakeRequest is sent by a client to a node to add itself as a peer of a session.
+type HandshakeRequest struct {
+	Data      []byte `json:"data"`      // Data is the peer request of the service, such as a WireGuard public key.
+	ID        uint64 `json:"id"`        // ID is the identifier of the session.
+	PubKey    []byte `json:"pub_key"`   // PubKey is the secp256k1 public key of the session account.
+	Signature []byte `json:"signature"` // Signature is the signature of the sign bytes by the session account.
+}
+
+// SignBytes returns the bytes signed by the session account, the big-endian session ID followed by the data.
+func (r *HandshakeRequest) SignBytes() []byte {
+	return append(binary.BigEndian.AppendUint64(nil, r.ID), r.Data...)
+}
+
+// HandshakeResult is returned by a node after adding a client as a peer of a session.
+type HandshakeResult struct {
+	Addrs []string `json:"addrs"` // Addrs are the addresses the client connects to.
+	Data  []byte   `json:"data"`  // Data is the peer result of the service, such as the assigned IP addresses.
+}
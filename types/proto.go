@@ -8,7 +8,14 @@ import (
 	authvestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	vpntypes "github.com/sentinel-official/hub/v12/x/vpn/types/v1"
 )
 
@@ -23,7 +30,16 @@ func NewInterfaceRegistry() codectypes.InterfaceRegistry {
 	authvestingtypes.RegisterInterfaces(registry)
 	authz.RegisterInterfaces(registry)
 	banktypes.RegisterInterfaces(registry)
+	distributiontypes.RegisterInterfaces(registry)
 	feegrant.RegisterInterfaces(registry)
+	govv1.RegisterInterfaces(registry)
+	govv1beta1.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+
+	// Register IBC module interfaces.
+	clienttypes.RegisterInterfaces(registry)
+	ibctmtypes.RegisterInterfaces(registry)
+	transfertypes.RegisterInterfaces(registry)
 
 	// Register Sentinel Hub module interfaces.
 	vpntypes.RegisterInterfaces(registry)
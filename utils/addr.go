@@ -1,20 +1,105 @@
 package utils
 
 import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkbech32 "github.com/cosmos/cosmos-sdk/types/bech32"
 	base "github.com/sentinel-official/hub/v12/types"
 )
 
-// MustAccAddrFromBech32 converts a Bech32-encoded string to a sdk.AccAddress,
-// panicking if there is an error during the conversion.
-func MustAccAddrFromBech32(v string) sdk.AccAddress {
-	// If the input string is empty, return nil
+// Kinds of addresses, each encoded with its own Bech32 prefix.
+const (
+	AddrKindAcc  = "acc"
+	AddrKindNode = "node"
+	AddrKindProv = "prov"
+	AddrKindVal  = "val"
+)
+
+// AccAddrFromBech32 converts a Bech32-encoded string to a sdk.AccAddress.
+// It returns nil without an error if the input string is empty.
+func AccAddrFromBech32(v string) (sdk.AccAddress, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	return sdk.AccAddressFromBech32(v)
+}
+
+// NodeAddrFromBech32 converts a Bech32-encoded string to a base.NodeAddress.
+// It returns nil without an error if the input string is empty.
+func NodeAddrFromBech32(v string) (base.NodeAddress, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	return base.NodeAddressFromBech32(v)
+}
+
+// ProvAddrFromBech32 converts a Bech32-encoded string to a base.ProvAddress.
+// It returns nil without an error if the input string is empty.
+func ProvAddrFromBech32(v string) (base.ProvAddress, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	return base.ProvAddressFromBech32(v)
+}
+
+// ValAddrFromBech32 converts a Bech32-encoded string to a sdk.ValAddress.
+// It returns nil without an error if the input string is empty.
+func ValAddrFromBech32(v string) (sdk.ValAddress, error) {
 	if v == "" {
-		return nil
+		return nil, nil
 	}
 
+	return sdk.ValAddressFromBech32(v)
+}
+
+// AddrBytesFromString decodes an address given either as a Bech32 string with any prefix
+// or as a hex string, and returns its raw bytes.
+func AddrBytesFromString(v string) ([]byte, error) {
+	if v == "" {
+		return nil, fmt.Errorf("empty address")
+	}
+
+	// Attempt to decode the input as a hex string first.
+	if buf, err := hex.DecodeString(strings.TrimPrefix(v, "0x")); err == nil {
+		return buf, sdk.VerifyAddressFormat(buf)
+	}
+
+	// Otherwise decode the input as a Bech32 string, ignoring its prefix.
+	_, buf, err := sdkbech32.DecodeAndConvert(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, sdk.VerifyAddressFormat(buf)
+}
+
+// Bech32FromAddrBytes encodes the raw address bytes as a Bech32 string for the given address kind.
+func Bech32FromAddrBytes(kind string, buf []byte) (string, error) {
+	switch kind {
+	case AddrKindAcc:
+		return sdk.AccAddress(buf).String(), nil
+	case AddrKindNode:
+		return base.NodeAddress(buf).String(), nil
+	case AddrKindProv:
+		return base.ProvAddress(buf).String(), nil
+	case AddrKindVal:
+		return sdk.ValAddress(buf).String(), nil
+	default:
+		return "", fmt.Errorf("invalid address kind %s", kind)
+	}
+}
+
+// MustAccAddrFromBech32 converts a Bech32-encoded string to a sdk.AccAddress,
+// panicking if there is an error during the conversion.
+func MustAccAddrFromBech32(v string) sdk.AccAddress {
 	// Attempt to convert the Bech32 string to a sdk.AccAddress
-	addr, err := sdk.AccAddressFromBech32(v)
+	addr, err := AccAddrFromBech32(v)
 
 	// If there is an error during the conversion, panic
 	if err != nil {
@@ -28,13 +113,8 @@ func MustAccAddrFromBech32(v string) sdk.AccAddress {
 // MustNodeAddrFromBech32 converts a Bech32-encoded string to a base.NodeAddress,
 // panicking if there is an error during the conversion.
 func MustNodeAddrFromBech32(v string) base.NodeAddress {
-	// If the input string is empty, return nil
-	if v == "" {
-		return nil
-	}
-
 	// Attempt to convert the Bech32 string to a base.NodeAddress
-	addr, err := base.NodeAddressFromBech32(v)
+	addr, err := NodeAddrFromBech32(v)
 
 	// If there is an error during the conversion, panic
 	if err != nil {
@@ -1,7 +1,14 @@
 package utils
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
 )
 
 // RemoveFile deletes the file at the specified path.
@@ -16,3 +23,50 @@ func RemoveFile(path string) error {
 	// Remove the file and return the resulting error, if any.
 	return os.Remove(path)
 }
+
+// FindFile returns the path of the first file in the directory with the given name and one of the extensions.
+// It returns an empty string if no such file exists.
+func FindFile(dir, name string, exts ...string) (string, error) {
+	for _, ext := range exts {
+		path := filepath.Join(dir, name+ext)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+	}
+
+	return "", nil
+}
+
+// DecodeFile decodes the TOML, YAML or JSON file at the path into v, with the format chosen by the
+// file extension. The file is decoded into a generic map first and re-encoded as JSON, so every format
+// is matched against the json tags of v.
+func DecodeFile(path string, v interface{}) error {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var m map[string]interface{}
+	switch ext := filepath.Ext(path); ext {
+	case ".toml":
+		err = toml.Unmarshal(buf, &m)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(buf, &m)
+	case ".json":
+		err = json.Unmarshal(buf, &m)
+	default:
+		return fmt.Errorf("unsupported file extension %s", ext)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode file %s: %w", path, err)
+	}
+
+	buf, err = json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buf, v)
+}
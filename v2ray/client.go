@@ -25,6 +25,14 @@ type Client struct {
 	name    string    // Name of the interface.
 }
 
+// NewClient creates a new V2Ray client with the given home directory and name.
+func NewClient(homeDir, name string) *Client {
+	return &Client{
+		homeDir: homeDir,
+		name:    name,
+	}
+}
+
 // configFilePath returns the file path of the client's configuration file.
 func (c *Client) configFilePath() string {
 	return filepath.Join(c.homeDir, fmt.Sprintf("%s.json", c.name))
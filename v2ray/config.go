@@ -18,7 +18,24 @@ var tmplFuncMap = template.FuncMap{
 
 // ToConfig generates the V2Ray client configuration as a string.
 func (co *ClientOptions) ToConfig() (string, error) {
-	return "", nil
+	text, err := fs.ReadFile("client.json.tmpl")
+	if err != nil {
+		return "", err
+	}
+
+	tmpl, err := template.New("config").
+		Funcs(tmplFuncMap).
+		Parse(string(text))
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, co); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
 }
 
 // ToConfig generates the V2Ray server configuration as a string.
@@ -0,0 +1,145 @@
+package v2ray
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/v2fly/v2ray-core/v5/common/uuid"
+)
+
+func TestClientOptions_ToConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol string
+		security string
+	}{
+		{"vmess", "vmess", "none"},
+		{"vless over tls", "vless", "tls"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uid := uuid.New()
+			opts := (&ClientOptions{}).
+				WithAddress("node.example").
+				WithNetwork("tcp").
+				WithPort(8080).
+				WithProtocol(tt.protocol).
+				WithProxyPort(DefaultProxyPort).
+				WithSecurity(tt.security).
+				WithUUID(uid.String())
+			if err := opts.Validate(); err != nil {
+				t.Fatal(err)
+			}
+
+			text, err := opts.ToConfig()
+			if err != nil {
+				t.Fatalf("ToConfig() error = %v", err)
+			}
+
+			var cfg struct {
+				Inbounds []struct {
+					Port     uint16 `json:"port"`
+					Protocol string `json:"protocol"`
+				} `json:"inbounds"`
+				Outbounds []struct {
+					Protocol string `json:"protocol"`
+					Settings struct {
+						VNext []struct {
+							Address string `json:"address"`
+							Port    uint16 `json:"port"`
+							Users   []struct {
+								Encryption string `json:"encryption"`
+								ID         string `json:"id"`
+							} `json:"users"`
+						} `json:"vnext"`
+					} `json:"settings"`
+					StreamSettings struct {
+						Network  string          `json:"network"`
+						Security string          `json:"security"`
+						TLS      json.RawMessage `json:"tlsSettings"`
+					} `json:"streamSettings"`
+				} `json:"outbounds"`
+			}
+			if err := json.Unmarshal([]byte(text), &cfg); err != nil {
+				t.Fatalf("ToConfig() is not valid JSON: %v\n%s", err, text)
+			}
+
+			if len(cfg.Inbounds) != 1 || cfg.Inbounds[0].Port != DefaultProxyPort || cfg.Inbounds[0].Protocol != "socks" {
+				t.Errorf("inbounds = %+v, want a socks proxy on port %d", cfg.Inbounds, DefaultProxyPort)
+			}
+			if len(cfg.Outbounds) != 1 || len(cfg.Outbounds[0].Settings.VNext) != 1 || len(cfg.Outbounds[0].Settings.VNext[0].Users) != 1 {
+				t.Fatalf("outbounds = %+v, want one server with one user", cfg.Outbounds)
+			}
+
+			out := cfg.Outbounds[0]
+			server := out.Settings.VNext[0]
+			if out.Protocol != tt.protocol || server.Address != "node.example" || server.Port != 8080 || server.Users[0].ID != uid.String() {
+				t.Errorf("outbound = %+v, want %s to node.example:8080 as %s", out, tt.protocol, uid)
+			}
+			if (server.Users[0].Encryption == "none") != (tt.protocol == "vless") {
+				t.Errorf("encryption = %q, want none for vless only", server.Users[0].Encryption)
+			}
+			if out.StreamSettings.Network != "tcp" || out.StreamSettings.Security != tt.security {
+				t.Errorf("streamSettings = %+v, want tcp with %s", out.StreamSettings, tt.security)
+			}
+			if (out.StreamSettings.TLS != nil) != (tt.security == "tls") {
+				t.Errorf("tlsSettings = %s, want them for tls only", out.StreamSettings.TLS)
+			}
+		})
+	}
+}
+
+func TestClientOptions_Validate(t *testing.T) {
+	uid := uuid.New()
+	valid := func() *ClientOptions {
+		return (&ClientOptions{}).
+			WithAddress("node.example").
+			WithNetwork("tcp").
+			WithPort(8080).
+			WithProtocol("vmess").
+			WithProxyPort(DefaultProxyPort).
+			WithSecurity("none").
+			WithUUID(uid.String())
+	}
+
+	tests := []struct {
+		name    string
+		opts    *ClientOptions
+		wantErr bool
+	}{
+		{"valid", valid(), false},
+		{"empty address", valid().WithAddress(""), true},
+		{"invalid network", valid().WithNetwork("udp"), true},
+		{"zero port", valid().WithPort(0), true},
+		{"invalid protocol", valid().WithProtocol("trojan"), true},
+		{"zero proxy port", valid().WithProxyPort(0), true},
+		{"invalid security", valid().WithSecurity("reality"), true},
+		{"invalid uuid", valid().WithUUID("not-a-uuid"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.opts.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServerOptions_Inbound(t *testing.T) {
+	opts := (&ServerOptions{}).WithInbounds(
+		(&InboundServerOptions{}).WithNetwork("tcp").WithPort(8080).WithProtocol("vmess").WithSecurity("none"),
+		(&InboundServerOptions{}).WithNetwork("grpc").WithPort(8443).WithProtocol("vless").WithSecurity("tls"),
+	)
+
+	req := &AddPeerRequest{Protocol: ProtocolVLess, Network: NetworkGRPC, Security: SecurityTLS}
+	if v := opts.Inbound(req.Tag()); v == nil || v.Port != 8443 {
+		t.Errorf("Inbound(%s) = %+v, want port 8443", req.Tag(), v)
+	}
+
+	req = &AddPeerRequest{Protocol: ProtocolVMess, Network: NetworkWebSocket, Security: SecurityNone}
+	if v := opts.Inbound(req.Tag()); v != nil {
+		t.Errorf("Inbound(%s) = %+v, want none", req.Tag(), v)
+	}
+}
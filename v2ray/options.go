@@ -8,29 +8,123 @@ import (
 
 	"github.com/pelletier/go-toml/v2"
 	"github.com/spf13/cobra"
+	"github.com/v2fly/v2ray-core/v5/common/uuid"
 )
 
-// ClientOptions represents the V2Ray client configuration options.
-type ClientOptions struct{}
+// DefaultProxyPort is the default port of the local SOCKS proxy of the client.
+const DefaultProxyPort = 1080
+
+// ClientOptions represents the V2Ray client configuration options. The client serves a local SOCKS proxy
+// on the proxy port, relaying the traffic to the inbound of the server at the address and port.
+type ClientOptions struct {
+	Address   string `json:"address"`    // Address is the host of the server.
+	Network   string `json:"network"`    // Network is the transport of the inbound, such as tcp.
+	Port      uint16 `json:"port"`       // Port is the port of the inbound.
+	Protocol  string `json:"protocol"`   // Protocol is the protocol of the inbound, such as vmess.
+	ProxyPort uint16 `json:"proxy_port"` // ProxyPort is the port of the local SOCKS proxy.
+	Security  string `json:"security"`   // Security is the security of the inbound, such as tls.
+	UUID      string `json:"uuid"`       // UUID is the identifier of the client added as a peer of the inbound.
+}
+
+// WithAddress sets the Address field and returns the modified ClientOptions instance.
+func (co *ClientOptions) WithAddress(v string) *ClientOptions {
+	co.Address = v
+	return co
+}
+
+// WithNetwork sets the Network field and returns the modified ClientOptions instance.
+func (co *ClientOptions) WithNetwork(v string) *ClientOptions {
+	co.Network = v
+	return co
+}
+
+// WithPort sets the Port field and returns the modified ClientOptions instance.
+func (co *ClientOptions) WithPort(v uint16) *ClientOptions {
+	co.Port = v
+	return co
+}
+
+// WithProtocol sets the Protocol field and returns the modified ClientOptions instance.
+func (co *ClientOptions) WithProtocol(v string) *ClientOptions {
+	co.Protocol = v
+	return co
+}
+
+// WithProxyPort sets the ProxyPort field and returns the modified ClientOptions instance.
+func (co *ClientOptions) WithProxyPort(v uint16) *ClientOptions {
+	co.ProxyPort = v
+	return co
+}
+
+// WithSecurity sets the Security field and returns the modified ClientOptions instance.
+func (co *ClientOptions) WithSecurity(v string) *ClientOptions {
+	co.Security = v
+	return co
+}
+
+// WithUUID sets the UUID field and returns the modified ClientOptions instance.
+func (co *ClientOptions) WithUUID(v string) *ClientOptions {
+	co.UUID = v
+	return co
+}
+
+// Validate validates the ClientOptions fields.
+func (co *ClientOptions) Validate() error {
+	if co.Address == "" {
+		return errors.New("address cannot be empty")
+	}
+	if !NewNetworkFromString(co.Network).IsValid() {
+		return fmt.Errorf("invalid network value: %s", co.Network)
+	}
+	if co.Port == 0 {
+		return errors.New("port cannot be zero")
+	}
+	if !NewProtocolFromString(co.Protocol).IsValid() {
+		return fmt.Errorf("invalid protocol value: %s", co.Protocol)
+	}
+	if co.ProxyPort == 0 {
+		return errors.New("proxy_port cannot be zero")
+	}
+	if !NewSecurityFromString(co.Security).IsValid() {
+		return fmt.Errorf("invalid security value: %s", co.Security)
+	}
+	if _, err := uuid.ParseString(co.UUID); err != nil {
+		return fmt.Errorf("invalid uuid: %w", err)
+	}
+
+	return nil
+}
 
 // WriteToFile writes the ClientOptions configuration to a TOML file.
+// The file holds the UUID of the client, so it is readable by the owner only.
 func (co *ClientOptions) WriteToFile(filepath string) error {
 	data, err := toml.Marshal(co)
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(filepath, data, 0644)
+	return writePrivateFile(filepath, data)
 }
 
 // WriteConfigToFile writes the ClientOptions configuration to a file in JSON format.
+// The file holds the UUID of the client, so it is readable by the owner only.
 func (co *ClientOptions) WriteConfigToFile(filepath string) error {
 	data, err := co.ToConfig()
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(filepath, []byte(data), 0644)
+	return writePrivateFile(filepath, []byte(data))
+}
+
+// writePrivateFile writes the data to a file readable by the owner only. The mode of an existing
+// file is restricted as well, since os.WriteFile keeps it.
+func writePrivateFile(filepath string, data []byte) error {
+	if err := os.WriteFile(filepath, data, 0600); err != nil {
+		return err
+	}
+
+	return os.Chmod(filepath, 0600)
 }
 
 // NewClientOptionsFromFile reads the configuration from a TOML file and unmarshals it into a ClientOptions instance.
@@ -138,6 +232,17 @@ type ServerOptions struct {
 	Inbounds []*InboundServerOptions `json:"inbounds"`
 }
 
+// Inbound returns the inbound with the given tag, or nil if none exists.
+func (so *ServerOptions) Inbound(tag *Tag) *InboundServerOptions {
+	for _, inbound := range so.Inbounds {
+		if inbound.Tag().String() == tag.String() {
+			return inbound
+		}
+	}
+
+	return nil
+}
+
 // WithInbounds sets the Inbounds field.
 func (so *ServerOptions) WithInbounds(inbounds ...*InboundServerOptions) *ServerOptions {
 	so.Inbounds = inbounds
@@ -17,14 +17,9 @@ var tmplFuncMap = template.FuncMap{
 	},
 }
 
-// ToConfig generates the WireGuard client configuration as a string.
-func (co *ClientOptions) ToConfig() (string, error) {
-	return "", nil
-}
-
-// ToConfig generates the WireGuard server configuration as a string.
-func (so *ServerOptions) ToConfig() (string, error) {
-	text, err := fs.ReadFile("server.conf.tmpl")
+// executeTemplate renders the embedded template file with the given data.
+func executeTemplate(name string, data interface{}) (string, error) {
+	text, err := fs.ReadFile(name)
 	if err != nil {
 		return "", err
 	}
@@ -37,9 +32,19 @@ func (so *ServerOptions) ToConfig() (string, error) {
 	}
 
 	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, so); err != nil {
+	if err := tmpl.Execute(&buf, data); err != nil {
 		return "", err
 	}
 
 	return buf.String(), nil
 }
+
+// ToConfig generates the WireGuard client configuration as a string.
+func (co *ClientOptions) ToConfig() (string, error) {
+	return executeTemplate("client.conf.tmpl", co)
+}
+
+// ToConfig generates the WireGuard server configuration as a string.
+func (so *ServerOptions) ToConfig() (string, error) {
+	return executeTemplate("server.conf.tmpl", so)
+}
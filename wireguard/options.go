@@ -3,6 +3,7 @@ package wireguard
 import (
 	"errors"
 	"fmt"
+	"net"
 	"net/netip"
 	"os"
 
@@ -11,26 +12,128 @@ import (
 )
 
 // ClientOptions represents the WireGuard client configuration options.
-type ClientOptions struct{}
+type ClientOptions struct {
+	Addresses      []string `json:"addresses"`
+	DNSAddrs       []string `json:"dns_addrs"`
+	PeerAllowedIPs []string `json:"peer_allowed_ips"`
+	PeerEndpoint   string   `json:"peer_endpoint"`
+	PeerPublicKey  string   `json:"peer_public_key"`
+	PrivateKey     string   `json:"private_key"`
+}
+
+// WithAddresses sets the Addresses field and returns the modified ClientOptions instance.
+func (co *ClientOptions) WithAddresses(v ...string) *ClientOptions {
+	co.Addresses = v
+	return co
+}
+
+// WithDNSAddrs sets the DNSAddrs field and returns the modified ClientOptions instance.
+func (co *ClientOptions) WithDNSAddrs(v ...string) *ClientOptions {
+	co.DNSAddrs = v
+	return co
+}
+
+// WithPeerAllowedIPs sets the PeerAllowedIPs field and returns the modified ClientOptions instance.
+func (co *ClientOptions) WithPeerAllowedIPs(v ...string) *ClientOptions {
+	co.PeerAllowedIPs = v
+	return co
+}
+
+// WithPeerEndpoint sets the PeerEndpoint field and returns the modified ClientOptions instance.
+func (co *ClientOptions) WithPeerEndpoint(v string) *ClientOptions {
+	co.PeerEndpoint = v
+	return co
+}
+
+// WithPeerPublicKey sets the PeerPublicKey field and returns the modified ClientOptions instance.
+func (co *ClientOptions) WithPeerPublicKey(v string) *ClientOptions {
+	co.PeerPublicKey = v
+	return co
+}
+
+// WithPrivateKey sets the PrivateKey field and returns the modified ClientOptions instance.
+func (co *ClientOptions) WithPrivateKey(v string) *ClientOptions {
+	co.PrivateKey = v
+	return co
+}
 
 // WriteToFile writes the ClientOptions configuration to a TOML file.
+// The file holds the private key, so it is readable by the owner only.
 func (co *ClientOptions) WriteToFile(filepath string) error {
 	data, err := toml.Marshal(co)
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(filepath, data, 0644)
+	return writePrivateFile(filepath, data)
 }
 
 // WriteConfigToFile writes the WireGuard configuration to a file in a format recognized by WireGuard.
+// The file holds the private key, so it is readable by the owner only.
 func (co *ClientOptions) WriteConfigToFile(filepath string) error {
 	data, err := co.ToConfig()
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(filepath, []byte(data), 0644)
+	return writePrivateFile(filepath, []byte(data))
+}
+
+// writePrivateFile writes the data to a file readable by the owner only. The mode of an existing
+// file is restricted as well, since os.WriteFile keeps it.
+func writePrivateFile(filepath string, data []byte) error {
+	if err := os.WriteFile(filepath, data, 0600); err != nil {
+		return err
+	}
+
+	return os.Chmod(filepath, 0600)
+}
+
+// Validate checks that the ClientOptions fields have valid values.
+func (co *ClientOptions) Validate() error {
+	if len(co.Addresses) == 0 {
+		return errors.New("addresses cannot be empty")
+	}
+	for _, item := range co.Addresses {
+		_, err := netip.ParsePrefix(item)
+		if err != nil {
+			return fmt.Errorf("invalid address: %w", err)
+		}
+	}
+
+	for _, item := range co.DNSAddrs {
+		_, err := netip.ParseAddr(item)
+		if err != nil {
+			return fmt.Errorf("invalid dns_addr: %w", err)
+		}
+	}
+
+	if len(co.PeerAllowedIPs) == 0 {
+		return errors.New("peer_allowed_ips cannot be empty")
+	}
+	for _, item := range co.PeerAllowedIPs {
+		_, err := netip.ParsePrefix(item)
+		if err != nil {
+			return fmt.Errorf("invalid peer_allowed_ip: %w", err)
+		}
+	}
+
+	_, _, err := net.SplitHostPort(co.PeerEndpoint)
+	if err != nil {
+		return fmt.Errorf("invalid peer_endpoint: %w", err)
+	}
+
+	_, err = NewKeyFromString(co.PeerPublicKey)
+	if err != nil {
+		return fmt.Errorf("invalid peer_public_key: %w", err)
+	}
+
+	_, err = NewKeyFromString(co.PrivateKey)
+	if err != nil {
+		return fmt.Errorf("invalid private_key: %w", err)
+	}
+
+	return nil
 }
 
 // NewClientOptionsFromFile reads the configuration from a TOML file and unmarshals it into a ClientOptions instance.
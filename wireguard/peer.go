@@ -3,6 +3,7 @@ package wireguard
 import (
 	"fmt"
 	"net"
+	"net/netip"
 	"sync"
 )
 
@@ -36,6 +37,39 @@ func NewPeerManager(ipv4Addrs, ipv6Addrs []net.IP) *PeerManager {
 	}
 }
 
+// maxPeerAddrs is the maximum number of peer addresses taken from each server address prefix.
+const maxPeerAddrs = 1 << 16
+
+// NewPeerManagerFromAddresses creates a new instance of PeerManager with the peer addresses available in the
+// prefixes of the server addresses, such as 10.8.0.1/24, excluding the server address and the last address.
+func NewPeerManagerFromAddresses(addrs []string) (*PeerManager, error) {
+	var ipv4Addrs, ipv6Addrs []net.IP
+	for _, item := range addrs {
+		prefix, err := netip.ParsePrefix(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address: %w", err)
+		}
+
+		// Collect the addresses following the server address within its prefix.
+		var ips []net.IP
+		for addr := prefix.Addr().Next(); prefix.Contains(addr) && prefix.Contains(addr.Next()); addr = addr.Next() {
+			if len(ips) == maxPeerAddrs {
+				break
+			}
+
+			ips = append(ips, addr.AsSlice())
+		}
+
+		if prefix.Addr().Is4() {
+			ipv4Addrs = append(ipv4Addrs, ips...)
+		} else {
+			ipv6Addrs = append(ipv6Addrs, ips...)
+		}
+	}
+
+	return NewPeerManager(ipv4Addrs, ipv6Addrs), nil
+}
+
 // Get retrieves a Peer from the PeerManager by its identity.
 func (pm *PeerManager) Get(v string) *Peer {
 	pm.RLock()
@@ -24,6 +24,15 @@ type Server struct {
 	pm      *PeerManager // Peer manager for handling peer information.
 }
 
+// NewServer creates a new WireGuard server with the given home directory, interface name and peer manager.
+func NewServer(homeDir, name string, pm *PeerManager) *Server {
+	return &Server{
+		homeDir: homeDir,
+		name:    name,
+		pm:      pm,
+	}
+}
+
 // Info returns the server's information.
 func (s *Server) Info() []byte {
 	return s.info